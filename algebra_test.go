@@ -0,0 +1,162 @@
+package timerange_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+	"github.com/int128/go-timerange/timerangetest"
+)
+
+// maxSplitPoints is the maximum number of points in the fuzz tests of Split.
+const maxSplitPoints = 1000
+
+func FuzzIntersect(f *testing.F) {
+	base := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).UnixNano()
+	f.Add(base, int64(time.Hour), base, int64(time.Hour), base, int64(time.Hour))
+	f.Add(base, int64(time.Hour), base+int64(time.Hour), int64(time.Hour), base, int64(0))
+	f.Add(base, int64(time.Hour), base+int64(time.Minute), int64(time.Minute), base-int64(time.Hour), int64(3*time.Hour))
+	f.Add(base, int64(0), base, int64(0), base+1, int64(0))
+	f.Fuzz(func(t *testing.T, aStart, aDuration, bStart, bDuration, cStart, cDuration int64) {
+		a := timerangetest.FromFuzz(aStart, aDuration)
+		b := timerangetest.FromFuzz(bStart, bDuration)
+		c := timerangetest.FromFuzz(cStart, cDuration)
+		checkIntersectLaws(t, a, b, c)
+	})
+}
+
+func TestIntersect_property(t *testing.T) {
+	g := timerangetest.NewGenerator(1)
+	g.MaxDuration = 24 * time.Hour
+	g.Locations = []*time.Location{time.UTC, time.FixedZone("JST", 9*60*60)}
+	for range 10000 {
+		ranges := g.TimeRanges(3)
+		checkIntersectLaws(t, ranges[0], ranges[1], ranges[2])
+	}
+}
+
+func checkIntersectLaws(t *testing.T, a, b, c timerange.TimeRange) {
+	t.Helper()
+	ab := timerange.Intersect(a, b)
+	if ba := timerange.Intersect(b, a); !ab.Equal(ba) {
+		t.Fatalf("Intersect is not commutative: Intersect(%v, %v) = %v but Intersect(%v, %v) = %v", a, b, ab, b, a, ba)
+	}
+	if aa := timerange.Intersect(a, a); !a.Equal(aa) {
+		t.Fatalf("Intersect is not idempotent: Intersect(%v, %v) = %v", a, a, aa)
+	}
+	left := timerange.Intersect(ab, c)
+	right := timerange.Intersect(a, timerange.Intersect(b, c))
+	if !left.Equal(right) {
+		t.Fatalf("Intersect is not associative: (%v ∩ %v) ∩ %v = %v but %v ∩ (%v ∩ %v) = %v", a, b, c, left, a, b, c, right)
+	}
+	// A zero value contains the zero time, so check only the points around the ranges
+	for _, p := range boundaryPoints(a, b) {
+		want := a.Contains(p) && b.Contains(p)
+		if got := ab.Contains(p); want != got {
+			t.Fatalf("Intersect(%v, %v) = %v: Contains(%v) wants %v but was %v", a, b, ab, p, want, got)
+		}
+	}
+}
+
+// boundaryPoints returns the start, end, midpoint and their neighbors of the ranges.
+func boundaryPoints(ranges ...timerange.TimeRange) []time.Time {
+	var points []time.Time
+	for _, r := range ranges {
+		for _, p := range []time.Time{r.Start(), r.End(), r.Start().Add(r.Duration() / 2)} {
+			points = append(points, p.Add(-time.Nanosecond), p, p.Add(time.Nanosecond))
+		}
+	}
+	return points
+}
+
+func FuzzSplit(f *testing.F) {
+	base := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).UnixNano()
+	f.Add(base, int64(time.Hour), int64(time.Minute))
+	f.Add(base, int64(time.Hour), int64(7*time.Minute))
+	f.Add(base, int64(time.Hour), int64(2*time.Hour))
+	f.Add(base, int64(0), int64(1))
+	f.Fuzz(func(t *testing.T, start, duration, span int64) {
+		r := timerangetest.FromFuzz(start, duration)
+		// Bound the number of points
+		minSpan := r.Duration()/maxSplitPoints + 1
+		if span < 0 {
+			span = -(span + 1)
+		}
+		checkSplitLaws(t, r, minSpan+time.Duration(span%int64(timerangetest.MaxFuzzDuration)))
+	})
+}
+
+func TestTimeRange_Split_property(t *testing.T) {
+	g := timerangetest.NewGenerator(2)
+	g.Locations = []*time.Location{time.UTC, time.FixedZone("JST", 9*60*60)}
+	for range 1000 {
+		r := g.TimeRange()
+		span := g.Duration()/100 + r.Duration()/maxSplitPoints + 1
+		checkSplitLaws(t, r, span)
+	}
+}
+
+func checkSplitLaws(t *testing.T, r timerange.TimeRange, span time.Duration) {
+	t.Helper()
+	points := r.Split(span)
+	if len(points) == 0 {
+		t.Fatalf("%v.Split(%v) returned no point", r, span)
+	}
+	if !points[0].Equal(r.Start()) {
+		t.Fatalf("%v.Split(%v): first point wants %v but was %v", r, span, r.Start(), points[0])
+	}
+	for i, p := range points {
+		if !r.Contains(p) {
+			t.Fatalf("%v.Split(%v): points[%d] = %v is not contained", r, span, i, p)
+		}
+		if i > 0 && !points[i-1].Before(p) {
+			t.Fatalf("%v.Split(%v): points[%d] = %v is not after %v", r, span, i, p, points[i-1])
+		}
+	}
+	if next := points[len(points)-1].Add(span); r.Contains(next) {
+		t.Fatalf("%v.Split(%v): next point %v is contained but not returned", r, span, next)
+	}
+	it := r.SplitIterator(span)
+	for i := 0; it.HasNext(); i++ {
+		got := it.Next()
+		if i >= len(points) {
+			t.Fatalf("%v.SplitIterator(%v) returned more points than Split", r, span)
+		}
+		if !points[i].Equal(got) {
+			t.Fatalf("%v.SplitIterator(%v): points[%d] wants %v but was %v", r, span, i, points[i], got)
+		}
+	}
+}
+
+func FuzzTimeRange_Shift(f *testing.F) {
+	base := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).UnixNano()
+	f.Add(base, int64(time.Hour), int64(time.Minute))
+	f.Add(base, int64(0), int64(-time.Hour))
+	f.Fuzz(func(t *testing.T, start, duration, d int64) {
+		if d == math.MinInt64 {
+			t.Skip("-d overflows")
+		}
+		r := timerangetest.FromFuzz(start, duration)
+		checkShiftLaws(t, r, time.Duration(d))
+	})
+}
+
+func TestTimeRange_Shift_property(t *testing.T) {
+	g := timerangetest.NewGenerator(3)
+	g.Locations = []*time.Location{time.UTC, time.FixedZone("JST", 9*60*60)}
+	for range 10000 {
+		checkShiftLaws(t, g.TimeRange(), g.Duration()-g.MaxDuration/2)
+	}
+}
+
+func checkShiftLaws(t *testing.T, r timerange.TimeRange, d time.Duration) {
+	t.Helper()
+	shifted := r.Shift(d)
+	if got := shifted.Shift(-d); !r.Equal(got) {
+		t.Fatalf("%v.Shift(%v).Shift(%v) wants %v but was %v", r, d, -d, r, got)
+	}
+	if shifted.Duration() != r.Duration() {
+		t.Fatalf("%v.Shift(%v) = %v changed the duration", r, d, shifted)
+	}
+}
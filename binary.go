@@ -0,0 +1,124 @@
+package timerange
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+const binaryVersion byte = 1
+
+const (
+	// binaryFlagEnd indicates the end time is encoded by time.Time.MarshalBinary(),
+	// because its zone offset is different from the start time.
+	binaryFlagEnd byte = 1 << iota
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+//
+// The encoding consists of a version byte, flags, the start time in time.Time.MarshalBinary(),
+// and the end time in varint-encoded seconds and nanoseconds relative to the start time.
+// If the end time has a different zone offset from the start time,
+// it is encoded in time.Time.MarshalBinary() as well.
+// The monotonic clock reading is not encoded.
+func (r TimeRange) MarshalBinary() ([]byte, error) {
+	start, err := r.start.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var flags byte
+	_, startOffset := r.start.Zone()
+	_, endOffset := r.end.Zone()
+	if startOffset != endOffset || (r.start.Location() == time.UTC) != (r.end.Location() == time.UTC) {
+		flags |= binaryFlagEnd
+	}
+
+	b := make([]byte, 0, 3+len(start)+2*binary.MaxVarintLen64)
+	b = append(b, binaryVersion, flags, byte(len(start)))
+	b = append(b, start...)
+	if flags&binaryFlagEnd != 0 {
+		end, err := r.end.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, byte(len(end)))
+		return append(b, end...), nil
+	}
+	b = binary.AppendUvarint(b, uint64(r.end.Unix()-r.start.Unix()))
+	return binary.AppendVarint(b, int64(r.end.Nanosecond()-r.start.Nanosecond())), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *TimeRange) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("timerange.TimeRange.UnmarshalBinary: no data")
+	}
+	if data[0] != binaryVersion {
+		return errors.New("timerange.TimeRange.UnmarshalBinary: unsupported version")
+	}
+	if len(data) < 3 {
+		return errors.New("timerange.TimeRange.UnmarshalBinary: invalid length")
+	}
+	flags := data[1]
+	if flags&^binaryFlagEnd != 0 {
+		return errors.New("timerange.TimeRange.UnmarshalBinary: unsupported flags")
+	}
+	start, data, err := unmarshalBinaryTime(data[2:])
+	if err != nil {
+		return err
+	}
+
+	var end time.Time
+	if flags&binaryFlagEnd != 0 {
+		end, data, err = unmarshalBinaryTime(data)
+		if err != nil {
+			return err
+		}
+	} else {
+		sec, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errors.New("timerange.TimeRange.UnmarshalBinary: invalid end seconds")
+		}
+		data = data[n:]
+		nsec, n := binary.Varint(data)
+		if n <= 0 || nsec <= -1e9 || nsec >= 1e9 {
+			return errors.New("timerange.TimeRange.UnmarshalBinary: invalid end nanoseconds")
+		}
+		data = data[n:]
+		end = time.Unix(start.Unix()+int64(sec), int64(start.Nanosecond())+nsec).In(start.Location())
+	}
+	if len(data) > 0 {
+		return errors.New("timerange.TimeRange.UnmarshalBinary: invalid length")
+	}
+	if start.After(end) {
+		return errors.New("timerange.TimeRange.UnmarshalBinary: start time is after end time")
+	}
+	*r = TimeRange{start: start, end: end}
+	return nil
+}
+
+// unmarshalBinaryTime decodes a length-prefixed time.Time and returns the rest of data.
+func unmarshalBinaryTime(data []byte) (time.Time, []byte, error) {
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return time.Time{}, nil, errors.New("timerange.TimeRange.UnmarshalBinary: invalid length")
+	}
+	var t time.Time
+	if err := t.UnmarshalBinary(data[1 : 1+int(data[0])]); err != nil {
+		return time.Time{}, nil, err
+	}
+	// time.Time.UnmarshalBinary() does not validate the nanoseconds
+	if t.Nanosecond() >= 1e9 {
+		return time.Time{}, nil, errors.New("timerange.TimeRange.UnmarshalBinary: invalid nanoseconds")
+	}
+	return t, data[1+int(data[0]):], nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (r TimeRange) GobEncode() ([]byte, error) {
+	return r.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (r *TimeRange) GobDecode(data []byte) error {
+	return r.UnmarshalBinary(data)
+}
@@ -0,0 +1,193 @@
+package timerange_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestTimeRange_MarshalBinary(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %s", err)
+	}
+
+	for _, c := range []struct {
+		name string
+		r    timerange.TimeRange
+	}{
+		{"zero", timerange.TimeRange{}},
+		{"UTC", timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+		)},
+		{"nanoseconds", timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 999999999, jst),
+			time.Date(2006, 1, 2, 15, 7, 6, 1, jst),
+		)},
+		{"different locations", timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 3, 15, 7, 5, 0, jst),
+		)},
+		{"across daylight saving time", timerange.New(
+			time.Date(2006, 3, 1, 0, 0, 0, 0, newYork),
+			time.Date(2006, 5, 1, 0, 0, 0, 0, newYork),
+		)},
+		{"long range", timerange.New(
+			time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		)},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			data, err := c.r.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary error: %s", err)
+			}
+			t.Logf("%d bytes", len(data))
+			var got timerange.TimeRange
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary error: %s", err)
+			}
+			if !c.r.Equal(got) {
+				t.Errorf("want %v != got %v", c.r, got)
+			}
+			if want, got := c.r.String(), got.String(); want != got {
+				t.Errorf("want %s but was %s", want, got)
+			}
+		})
+	}
+}
+
+func TestTimeRange_UnmarshalBinary(t *testing.T) {
+	valid, err := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	).MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary error: %s", err)
+	}
+	for _, c := range []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"unsupported version", append([]byte{2}, valid[1:]...)},
+		{"truncated", valid[:len(valid)-1]},
+		{"trailing bytes", append(valid, 0)},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			var r timerange.TimeRange
+			if err := r.UnmarshalBinary(c.data); err == nil {
+				t.Errorf("want error but was nil (r=%v)", r)
+			}
+		})
+	}
+}
+
+func TestTimeRange_GobEncode(t *testing.T) {
+	want := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(want); err != nil {
+		t.Fatalf("Encode error: %s", err)
+	}
+	var got timerange.TimeRange
+	if err := gob.NewDecoder(&b).Decode(&got); err != nil {
+		t.Fatalf("Decode error: %s", err)
+	}
+	if !want.Equal(got) {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
+
+func FuzzTimeRange_UnmarshalBinary(f *testing.F) {
+	for _, r := range []timerange.TimeRange{
+		{},
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 3, 15, 7, 5, 0, time.FixedZone("JST", 9*60*60)),
+		),
+	} {
+		data, err := r.MarshalBinary()
+		if err != nil {
+			f.Fatalf("MarshalBinary error: %s", err)
+		}
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var r timerange.TimeRange
+		if err := r.UnmarshalBinary(data); err != nil {
+			return
+		}
+		if r.Start().After(r.End()) {
+			t.Fatalf("start is after end: %v", r)
+		}
+		encoded, err := r.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary error: %s", err)
+		}
+		var decoded timerange.TimeRange
+		if err := decoded.UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("UnmarshalBinary error: %s", err)
+		}
+		if !r.Equal(decoded) {
+			t.Errorf("want %v != got %v", r, decoded)
+		}
+	})
+}
+
+func BenchmarkTimeRange_MarshalBinary(b *testing.B) {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	b.Run("binary", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			data, err := r.MarshalBinary()
+			if err != nil {
+				b.Fatalf("MarshalBinary error: %s", err)
+			}
+			size = len(data)
+		}
+		b.ReportMetric(float64(size), "bytes")
+	})
+	b.Run("json", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			data, err := json.Marshal([]time.Time{r.Start(), r.End()})
+			if err != nil {
+				b.Fatalf("json.Marshal error: %s", err)
+			}
+			size = len(data)
+		}
+		b.ReportMetric(float64(size), "bytes")
+	})
+}
+
+func BenchmarkTimeRange_UnmarshalBinary(b *testing.B) {
+	data, err := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	).MarshalBinary()
+	if err != nil {
+		b.Fatalf("MarshalBinary error: %s", err)
+	}
+	for i := 0; i < b.N; i++ {
+		var r timerange.TimeRange
+		if err := r.UnmarshalBinary(data); err != nil {
+			b.Fatalf("UnmarshalBinary error: %s", err)
+		}
+	}
+}
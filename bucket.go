@@ -0,0 +1,166 @@
+package timerange
+
+import (
+	"iter"
+	"math"
+	"time"
+)
+
+// Bucketer assigns a time to fixed-size buckets aligned to an origin time.
+// The buckets are tumbling windows if the hop is equal to the size,
+// or hopping windows if the hop is shorter than the size.
+//
+// Each bucket is identified by an index, where the bucket 0 starts at the origin
+// and the bucket i starts at the origin + i * hop.
+// While a bucket range includes its end time as well as TimeRange,
+// a time at the end of a bucket is assigned to the next bucket.
+//
+// As the size of NewBucketer is a fixed duration, a daily bucket does not follow
+// a day which is shorter or longer due to daylight saving time.
+// Use NewCalendarBucketer for calendar days or months in a location.
+type Bucketer struct {
+	origin time.Time
+	size   time.Duration
+	hop    time.Duration
+	// period is the size and hop of calendar buckets, or zero for fixed-size buckets.
+	period Period
+}
+
+// NewBucketer returns a Bucketer of tumbling windows.
+// The origin determines the alignment and location of the buckets.
+// For example, midnight in a location aligns daily buckets to the location.
+// It panics if the size is not positive.
+func NewBucketer(origin time.Time, size time.Duration) Bucketer {
+	return NewHoppingBucketer(origin, size, size)
+}
+
+// NewHoppingBucketer returns a Bucketer of hopping windows,
+// that is, each bucket has the size and starts the hop after the previous one.
+// If the hop is shorter than the size, a time belongs to multiple buckets.
+// It panics if the size or hop is not positive, or the hop is longer than the size,
+// because a time between buckets would not belong to any bucket.
+func NewHoppingBucketer(origin time.Time, size, hop time.Duration) Bucketer {
+	if size <= 0 || hop <= 0 {
+		panic("timerange: non-positive bucket size or hop")
+	}
+	if hop > size {
+		panic("timerange: bucket hop is longer than size")
+	}
+	return Bucketer{origin: origin, size: size, hop: hop}
+}
+
+// NewCalendarBucketer returns a Bucketer of tumbling windows of the calendar period,
+// such as a day or month in the location of the origin.
+// The bucket i starts at the origin plus i times the period, and ends at the start of the bucket i+1.
+// For example, a daily bucket is 23 or 25 hours long on a day of daylight saving time transition.
+//
+// The calendar components are added by time.Time.AddDate, which normalizes a date such as February 30.
+// For monthly buckets, the origin should be on a day which exists in every month, such as the 1st.
+// It panics if any component of the period is negative or all components are zero.
+func NewCalendarBucketer(origin time.Time, period Period) Bucketer {
+	if period.Years < 0 || period.Months < 0 || period.Days < 0 || period.Duration < 0 || period.IsZero() {
+		panic("timerange: non-positive bucket period")
+	}
+	return Bucketer{origin: origin, period: period}
+}
+
+// Index returns the index of the latest bucket which contains the time.
+func (b Bucketer) Index(t time.Time) int64 {
+	if !b.period.IsZero() {
+		return b.calendarIndex(t)
+	}
+	return floorDiv(t.Sub(b.origin), b.hop)
+}
+
+// Indexes returns the first and last index of the buckets which contain the time.
+// For tumbling windows, both are equal to Index(t).
+func (b Bucketer) Indexes(t time.Time) (first, last int64) {
+	if !b.period.IsZero() {
+		index := b.calendarIndex(t)
+		return index, index
+	}
+	return floorDiv(t.Sub(b.origin)-b.size, b.hop) + 1, b.Index(t)
+}
+
+// Bucket returns the range of the bucket at the index.
+// The range is in the location of the origin.
+func (b Bucketer) Bucket(index int64) TimeRange {
+	if !b.period.IsZero() {
+		return New(b.calendarStart(index), b.calendarStart(index+1))
+	}
+	return From(b.origin.Add(time.Duration(index)*b.hop), b.size)
+}
+
+// BucketOf returns the range of the latest bucket which contains the time.
+func (b Bucketer) BucketOf(t time.Time) TimeRange {
+	return b.Bucket(b.Index(t))
+}
+
+// BucketsOf returns the ranges of all the buckets which contain the time.
+func (b Bucketer) BucketsOf(t time.Time) []TimeRange {
+	first, last := b.Indexes(t)
+	buckets := make([]TimeRange, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		buckets = append(buckets, b.Bucket(i))
+	}
+	return buckets
+}
+
+// Buckets returns an iterator of the index and range of the buckets
+// which contain any time within the range.
+func (b Bucketer) Buckets(r TimeRange) iter.Seq2[int64, TimeRange] {
+	return func(yield func(int64, TimeRange) bool) {
+		index, _ := b.Indexes(r.start)
+		if !b.period.IsZero() {
+			for bucket := b.Bucket(index); !bucket.start.After(r.end); bucket = b.Bucket(index) {
+				if !yield(index, bucket) {
+					return
+				}
+				index++
+			}
+			return
+		}
+		starts := New(b.Bucket(index).start, r.end).SplitIterator(b.hop)
+		for ; starts.HasNext(); index++ {
+			if !yield(index, From(starts.Next(), b.size)) {
+				return
+			}
+		}
+	}
+}
+
+// calendarStart returns the start of the calendar bucket at the index.
+func (b Bucketer) calendarStart(index int64) time.Time {
+	i := int(index)
+	return b.origin.
+		AddDate(b.period.Years*i, b.period.Months*i, b.period.Days*i).
+		Add(b.period.Duration * time.Duration(index))
+}
+
+// calendarIndex estimates the index by the average length of the period,
+// and then adjusts it by the actual starts of the buckets.
+func (b Bucketer) calendarIndex(t time.Time) int64 {
+	const day = 24 * 60 * 60
+	average := float64(b.period.Years)*365.2425*day +
+		float64(b.period.Months)*365.2425/12*day +
+		float64(b.period.Days)*day +
+		b.period.Duration.Seconds()
+	// Subtract Unix seconds, because time.Time.Sub saturates at about 292 years
+	elapsed := float64(t.Unix()-b.origin.Unix()) + float64(t.Nanosecond()-b.origin.Nanosecond())/1e9
+	index := int64(math.Floor(elapsed / average))
+	for b.calendarStart(index).After(t) {
+		index--
+	}
+	for !b.calendarStart(index + 1).After(t) {
+		index++
+	}
+	return index
+}
+
+func floorDiv(d, m time.Duration) int64 {
+	q := d / m
+	if d%m < 0 {
+		q--
+	}
+	return int64(q)
+}
@@ -0,0 +1,214 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+func TestBucketer_BucketOf(t *testing.T) {
+	b := timerange.NewBucketer(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), 15*time.Minute)
+
+	t.Run("within bucket", func(t *testing.T) {
+		got := b.BucketOf(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("boundary of buckets", func(t *testing.T) {
+		got := b.BucketOf(time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC))
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("before origin", func(t *testing.T) {
+		got := b.BucketOf(time.Date(2006, 1, 1, 23, 50, 0, 0, time.UTC))
+		want := timerange.New(
+			time.Date(2006, 1, 1, 23, 45, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+}
+
+func TestBucketer_Index(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	b := timerange.NewBucketer(time.Date(2006, 1, 2, 0, 0, 0, 0, tokyo), 24*time.Hour)
+
+	t.Run("aligned to location", func(t *testing.T) {
+		index := b.Index(time.Date(2006, 1, 3, 16, 0, 0, 0, time.UTC))
+		if index != 2 {
+			t.Errorf("want 2 but was %d", index)
+		}
+		got := b.Bucket(index).String()
+		want := "[2006-01-04T00:00:00+09:00, 2006-01-05T00:00:00+09:00]"
+		if want != got {
+			t.Errorf("want %v but was %v", want, got)
+		}
+	})
+	t.Run("negative", func(t *testing.T) {
+		index := b.Index(time.Date(2006, 1, 1, 0, 0, 0, 0, tokyo))
+		if index != -1 {
+			t.Errorf("want -1 but was %d", index)
+		}
+	})
+}
+
+func TestBucketer_BucketsOf(t *testing.T) {
+	b := timerange.NewHoppingBucketer(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), 15*time.Minute, 5*time.Minute)
+	got := b.BucketsOf(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+	want := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 14, 50, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 5, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 14, 55, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+		),
+	}
+	if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+		t.Errorf("want != got\n%s", diff)
+	}
+}
+
+func TestBucketer_Buckets(t *testing.T) {
+	b := timerange.NewBucketer(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), 15*time.Minute)
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+	)
+	var gotIndexes []int64
+	var got []timerange.TimeRange
+	for i, bucket := range b.Buckets(r) {
+		gotIndexes = append(gotIndexes, i)
+		got = append(got, bucket)
+	}
+	if diff := cmp.Diff([]int64{60, 61, 62}, gotIndexes); diff != "" {
+		t.Errorf("want != got\n%s", diff)
+	}
+	want := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 45, 0, 0, time.UTC),
+		),
+	}
+	if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+		t.Errorf("want != got\n%s", diff)
+	}
+}
+
+func TestNewHoppingBucketer(t *testing.T) {
+	t.Run("hop longer than size", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("want panic but was not")
+			}
+		}()
+		timerange.NewHoppingBucketer(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), time.Minute, time.Hour)
+	})
+}
+
+func TestNewCalendarBucketer(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %s", err)
+	}
+
+	t.Run("daily over daylight saving time", func(t *testing.T) {
+		b := timerange.NewCalendarBucketer(time.Date(2026, 3, 1, 0, 0, 0, 0, newYork), timerange.Period{Days: 1})
+		got := b.BucketOf(time.Date(2026, 3, 8, 23, 30, 0, 0, newYork))
+		want := timerange.New(
+			time.Date(2026, 3, 8, 0, 0, 0, 0, newYork),
+			time.Date(2026, 3, 9, 0, 0, 0, 0, newYork),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+		if want, got := 23*time.Hour, got.Duration(); want != got {
+			t.Errorf("Duration wants %v but was %v", want, got)
+		}
+		if got := b.BucketOf(time.Date(2026, 11, 1, 23, 30, 0, 0, newYork)).Duration(); got != 25*time.Hour {
+			t.Errorf("Duration wants %v but was %v", 25*time.Hour, got)
+		}
+	})
+	t.Run("monthly", func(t *testing.T) {
+		b := timerange.NewCalendarBucketer(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), timerange.Period{Months: 1})
+		for _, c := range []struct {
+			t     time.Time
+			index int64
+		}{
+			{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 0},
+			{time.Date(2026, 2, 28, 23, 59, 59, 0, time.UTC), 1},
+			{time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), 2},
+			{time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), -1},
+			{time.Date(2526, 1, 15, 0, 0, 0, 0, time.UTC), 6000},
+		} {
+			if got := b.Index(c.t); c.index != got {
+				t.Errorf("Index(%v) wants %d but was %d", c.t, c.index, got)
+			}
+			if first, last := b.Indexes(c.t); first != c.index || last != c.index {
+				t.Errorf("Indexes(%v) wants %d, %d but was %d, %d", c.t, c.index, c.index, first, last)
+			}
+			if bucket := b.Bucket(c.index); !bucket.Contains(c.t) {
+				t.Errorf("Bucket(%d) = %v does not contain %v", c.index, bucket, c.t)
+			}
+		}
+	})
+	t.Run("Buckets", func(t *testing.T) {
+		b := timerange.NewCalendarBucketer(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), timerange.Period{Months: 1})
+		r := timerange.New(
+			time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		)
+		var gotIndexes []int64
+		var got []timerange.TimeRange
+		for i, bucket := range b.Buckets(r) {
+			gotIndexes = append(gotIndexes, i)
+			got = append(got, bucket)
+		}
+		if diff := cmp.Diff([]int64{0, 1, 2}, gotIndexes); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+		want := []timerange.TimeRange{
+			timerange.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)),
+			timerange.New(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)),
+			timerange.New(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("non-positive period", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("want panic but was not")
+			}
+		}()
+		timerange.NewCalendarBucketer(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), timerange.Period{Months: 1, Days: -1})
+	})
+}
@@ -0,0 +1,57 @@
+package timerange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrOverflow is returned when a time or duration exceeds the representable range.
+var ErrOverflow = errors.New("timerange: overflow")
+
+// ShiftChecked returns a TimeRange moved by the duration, as well as Shift.
+// If the start time or end time overflows the range of time.Time, this returns ErrOverflow.
+func (r TimeRange) ShiftChecked(d time.Duration) (TimeRange, error) {
+	start, err := addChecked(r.start, d)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	end, err := addChecked(r.end, d)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	return New(start, end), nil
+}
+
+// ExtendChecked returns an extended TimeRange for the duration, as well as Extend.
+// If the end time overflows the range of time.Time, this returns ErrOverflow.
+// If the end time passes the start time, this returns a zero value without error as well as Extend.
+func (r TimeRange) ExtendChecked(d time.Duration) (TimeRange, error) {
+	end, err := addChecked(r.end, d)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	return New(r.start, end), nil
+}
+
+// DurationChecked returns the duration between start time and end time, as well as Duration.
+// If the duration exceeds the range of time.Duration, that is, about 292 years,
+// this returns ErrOverflow instead of the saturated duration.
+func (r TimeRange) DurationChecked() (time.Duration, error) {
+	d := r.Duration()
+	if !r.start.Add(d).Equal(r.end) {
+		return 0, fmt.Errorf("%w: duration from %s to %s", ErrOverflow, r.start, r.end)
+	}
+	return d, nil
+}
+
+// addChecked returns t + d.
+// time.Time.Add silently saturates if the result exceeds the range of time.Time.
+func addChecked(t time.Time, d time.Duration) (time.Time, error) {
+	u := t.Add(d)
+	// Compare the wall clock readings, because the monotonic clock reading is stripped on overflow.
+	if u.Round(0).Sub(t.Round(0)) != d {
+		return time.Time{}, fmt.Errorf("%w: %s + %s", ErrOverflow, t, d)
+	}
+	return u, nil
+}
@@ -0,0 +1,224 @@
+package timerange_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+// The representable range of time.Time, which counts seconds since year 1 in int64.
+// There are 62135596800 seconds from year 1 to 1970.
+var (
+	minRepresentableTime = func() time.Time {
+		t := time.Unix(math.MinInt64, 0).UTC()
+		for range 8 {
+			t = t.Add(-62135596800 / 8 * time.Second)
+		}
+		return t
+	}()
+	maxRepresentableTime = time.Unix(math.MaxInt64-62135596800, 999999999).UTC()
+)
+
+func TestTimeRange_ShiftChecked(t *testing.T) {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	t.Run("valid", func(t *testing.T) {
+		got, err := r.ShiftChecked(time.Hour)
+		if err != nil {
+			t.Fatalf("ShiftChecked error: %s", err)
+		}
+		if want := r.Shift(time.Hour); !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("overflow", func(t *testing.T) {
+		r := timerange.New(maxRepresentableTime.Add(-time.Hour), maxRepresentableTime)
+		if _, err := r.ShiftChecked(time.Nanosecond); !errors.Is(err, timerange.ErrOverflow) {
+			t.Errorf("want ErrOverflow but was %v", err)
+		}
+	})
+	t.Run("underflow", func(t *testing.T) {
+		r := timerange.New(minRepresentableTime, minRepresentableTime.Add(time.Hour))
+		if _, err := r.ShiftChecked(-time.Nanosecond); !errors.Is(err, timerange.ErrOverflow) {
+			t.Errorf("want ErrOverflow but was %v", err)
+		}
+	})
+}
+
+func TestTimeRange_ExtendChecked(t *testing.T) {
+	t.Run("overflow", func(t *testing.T) {
+		r := timerange.New(maxRepresentableTime.Add(-time.Hour), maxRepresentableTime)
+		if _, err := r.ExtendChecked(time.Nanosecond); !errors.Is(err, timerange.ErrOverflow) {
+			t.Errorf("want ErrOverflow but was %v", err)
+		}
+	})
+	t.Run("shorter", func(t *testing.T) {
+		r := timerange.New(maxRepresentableTime.Add(-time.Hour), maxRepresentableTime)
+		got, err := r.ExtendChecked(-time.Minute)
+		if err != nil {
+			t.Fatalf("ExtendChecked error: %s", err)
+		}
+		if want, got := 59*time.Minute, got.Duration(); want != got {
+			t.Errorf("Duration wants %v but was %v", want, got)
+		}
+	})
+	t.Run("past the start", func(t *testing.T) {
+		r := timerange.New(maxRepresentableTime.Add(-time.Hour), maxRepresentableTime)
+		got, err := r.ExtendChecked(-2 * time.Hour)
+		if err != nil {
+			t.Fatalf("ExtendChecked error: %s", err)
+		}
+		if !got.IsZero() {
+			t.Errorf("want zero but was %v", got)
+		}
+	})
+}
+
+func TestTimeRange_DurationChecked(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		r := timerange.New(
+			time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		)
+		got, err := r.DurationChecked()
+		if err != nil {
+			t.Fatalf("DurationChecked error: %s", err)
+		}
+		if want := r.Duration(); want != got {
+			t.Errorf("want %v but was %v", want, got)
+		}
+	})
+	t.Run("maximum", func(t *testing.T) {
+		start := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+		r := timerange.New(start, start.Add(math.MaxInt64))
+		got, err := r.DurationChecked()
+		if err != nil {
+			t.Fatalf("DurationChecked error: %s", err)
+		}
+		if want := time.Duration(math.MaxInt64); want != got {
+			t.Errorf("want %v but was %v", want, got)
+		}
+	})
+	t.Run("saturated", func(t *testing.T) {
+		start := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+		r := timerange.New(start, start.Add(math.MaxInt64).Add(time.Nanosecond))
+		got, err := r.DurationChecked()
+		if !errors.Is(err, timerange.ErrOverflow) {
+			t.Errorf("want ErrOverflow but was %v", err)
+		}
+		if got != 0 {
+			t.Errorf("want 0 but was %v", got)
+		}
+	})
+	t.Run("whole representable range", func(t *testing.T) {
+		r := timerange.New(minRepresentableTime, maxRepresentableTime)
+		if _, err := r.DurationChecked(); !errors.Is(err, timerange.ErrOverflow) {
+			t.Errorf("want ErrOverflow but was %v", err)
+		}
+	})
+}
+
+// randomTimeNearBounds returns a time within a few years from the minimum or maximum representable time.
+func randomTimeNearBounds(rng *rand.Rand) time.Time {
+	offset := time.Duration(rng.Int64N(int64(3 * 365 * 24 * time.Hour)))
+	if rng.IntN(2) == 0 {
+		return minRepresentableTime.Add(offset)
+	}
+	return maxRepresentableTime.Add(-offset)
+}
+
+func randomDuration(rng *rand.Rand) time.Duration {
+	switch rng.IntN(3) {
+	case 0:
+		return time.Duration(rng.Int64())
+	case 1:
+		return -time.Duration(rng.Int64())
+	}
+	return time.Duration(rng.Int64N(int64(10*365*24*time.Hour))) - 5*365*24*time.Hour
+}
+
+func TestTimeRange_ShiftChecked_property(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 10000 {
+		start := randomTimeNearBounds(rng)
+		r := timerange.New(start, start.Add(time.Duration(rng.Int64N(int64(time.Hour)))))
+		d := randomDuration(rng)
+		got, err := r.ShiftChecked(d)
+		if err != nil {
+			// time.Time.Add must have saturated
+			if shifted := r.Shift(d); shifted.Start().Sub(r.Start()) == d && shifted.End().Sub(r.End()) == d {
+				t.Fatalf("%v.ShiftChecked(%v) returned error but Shift was valid: %s", r, d, err)
+			}
+			continue
+		}
+		if got.Start().Sub(r.Start()) != d || got.End().Sub(r.End()) != d {
+			t.Fatalf("%v.ShiftChecked(%v) = %v is not shifted by the duration", r, d, got)
+		}
+		if got.Duration() != r.Duration() {
+			t.Fatalf("%v.ShiftChecked(%v) = %v changed the duration", r, d, got)
+		}
+	}
+}
+
+func TestTimeRange_ExtendChecked_property(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	for range 10000 {
+		start := randomTimeNearBounds(rng)
+		r := timerange.New(start, start.Add(time.Duration(rng.Int64N(int64(time.Hour)))))
+		d := randomDuration(rng)
+		got, err := r.ExtendChecked(d)
+		if err != nil {
+			continue
+		}
+		if got.IsZero() {
+			if !r.End().Add(d).Before(r.Start()) {
+				t.Fatalf("%v.ExtendChecked(%v) returned zero but the end is not before the start", r, d)
+			}
+			continue
+		}
+		if !got.Start().Equal(r.Start()) || got.End().Sub(r.End()) != d {
+			t.Fatalf("%v.ExtendChecked(%v) = %v is not extended by the duration", r, d, got)
+		}
+	}
+}
+
+func TestTimeRange_DurationChecked_property(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	for range 10000 {
+		a, b := randomTimeNearBounds(rng), randomTimeNearBounds(rng)
+		if rng.IntN(2) == 0 {
+			b = a.Add(randomDuration(rng))
+		}
+		r := timerange.New(minTestTime(a, b), maxTestTime(a, b))
+		got, err := r.DurationChecked()
+		if err != nil {
+			if d := r.Duration(); d != math.MaxInt64 {
+				t.Fatalf("%v.DurationChecked returned error but Duration was not saturated: %v", r, d)
+			}
+			continue
+		}
+		if !r.Start().Add(got).Equal(r.End()) {
+			t.Fatalf("%v.DurationChecked = %v is not the duration", r, got)
+		}
+	}
+}
+
+func minTestTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTestTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,35 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestFakeClock(t *testing.T) {
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+	t.Run("Now", func(t *testing.T) {
+		got := clock.Now()
+		want := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+		if !want.Equal(got) {
+			t.Errorf("want %s != got %s", want, got)
+		}
+	})
+	t.Run("Advance", func(t *testing.T) {
+		clock.Advance(15 * time.Minute)
+		got := clock.Now()
+		want := time.Date(2006, 1, 2, 15, 19, 5, 0, time.UTC)
+		if !want.Equal(got) {
+			t.Errorf("want %s != got %s", want, got)
+		}
+	})
+	t.Run("Set", func(t *testing.T) {
+		clock.Set(time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC))
+		got := clock.Now()
+		want := time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC)
+		if !want.Equal(got) {
+			t.Errorf("want %s != got %s", want, got)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func runParse(e *env, args []string) error {
+	ranges, err := e.ranges(args)
+	if err != nil {
+		return err
+	}
+	return e.writeRanges(ranges)
+}
+
+func runIntersect(e *env, args []string) error {
+	ranges, err := e.ranges(args)
+	if err != nil {
+		return err
+	}
+	var intersection []timerange.TimeRange
+	if r, ok := timerange.IntersectAll(ranges...); ok {
+		intersection = append(intersection, r)
+	}
+	return e.writeRanges(intersection)
+}
+
+func runUnion(e *env, args []string) error {
+	ranges, err := e.ranges(args)
+	if err != nil {
+		return err
+	}
+	return e.writeRanges(timerange.Merge(ranges, 0))
+}
+
+func runDiff(e *env, args []string) error {
+	ranges, err := e.ranges(args)
+	if err != nil {
+		return err
+	}
+	if len(ranges) == 0 {
+		return errors.New("missing base range")
+	}
+	return e.writeRanges(timerange.Gaps(ranges[0], ranges[1:]))
+}
+
+func runSplit(e *env, args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing span")
+	}
+	span, err := time.ParseDuration(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid span: %w", err)
+	}
+	if span <= 0 {
+		return fmt.Errorf("span must be positive but was %s", span)
+	}
+	ranges, err := e.ranges(args[1:])
+	if err != nil {
+		return err
+	}
+	var slots []timerange.TimeRange
+	for _, r := range ranges {
+		for _, start := range r.Split(span) {
+			slot := timerange.Intersect(timerange.From(start, span), r)
+			if slot.Duration() > 0 {
+				slots = append(slots, slot)
+			}
+		}
+	}
+	return e.writeRanges(slots)
+}
+
+func runContains(e *env, args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing time")
+	}
+	t, err := parseTime(args[0], e.clock, e.loc)
+	if err != nil {
+		return err
+	}
+	ranges, err := e.ranges(args[1:])
+	if err != nil {
+		return err
+	}
+	results := make([]bool, 0, len(ranges))
+	for _, r := range ranges {
+		results = append(results, r.Contains(t))
+	}
+	if e.json {
+		return e.writeJSON(results)
+	}
+	lines := make([]string, 0, len(results))
+	for _, result := range results {
+		lines = append(lines, strconv.FormatBool(result))
+	}
+	return e.writeLines(lines)
+}
+
+func runDuration(e *env, args []string) error {
+	ranges, err := e.ranges(args)
+	if err != nil {
+		return err
+	}
+	durations := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		durations = append(durations, r.Duration().String())
+	}
+	if e.json {
+		return e.writeJSON(durations)
+	}
+	return e.writeLines(durations)
+}
+
+func runConvertTZ(e *env, args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing location")
+	}
+	loc, err := time.LoadLocation(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid location: %w", err)
+	}
+	ranges, err := e.ranges(args[1:])
+	if err != nil {
+		return err
+	}
+	converted := make([]timerange.TimeRange, 0, len(ranges))
+	for _, r := range ranges {
+		converted = append(converted, timerange.New(r.Start().In(loc), r.End().In(loc)))
+	}
+	return e.writeRanges(converted)
+}
+
+var layouts = map[string]string{
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+	"rfc1123":     time.RFC1123,
+	"datetime":    time.DateTime,
+	"date":        time.DateOnly,
+	"time":        time.TimeOnly,
+	"kitchen":     time.Kitchen,
+}
+
+func runFormat(e *env, args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing layout")
+	}
+	if args[0] == "human" {
+		return runFormatHuman(e, args[1:])
+	}
+	layout, ok := layouts[args[0]]
+	if !ok {
+		layout = args[0]
+	}
+	ranges, err := e.ranges(args[1:])
+	if err != nil {
+		return err
+	}
+	type formatted struct {
+		Start string `json:"start"`
+		End   string `json:"end"`
+	}
+	values := make([]formatted, 0, len(ranges))
+	for _, r := range ranges {
+		values = append(values, formatted{Start: r.Start().Format(layout), End: r.End().Format(layout)})
+	}
+	if e.json {
+		return e.writeJSON(values)
+	}
+	lines := make([]string, 0, len(values))
+	for _, v := range values {
+		lines = append(lines, fmt.Sprintf("[%s, %s]", v.Start, v.End))
+	}
+	return e.writeLines(lines)
+}
+
+func runFormatHuman(e *env, args []string) error {
+	ranges, err := e.ranges(args)
+	if err != nil {
+		return err
+	}
+	lines := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		lines = append(lines, timerange.DefaultFormatter.Format(r))
+	}
+	if e.json {
+		return e.writeJSON(lines)
+	}
+	return e.writeLines(lines)
+}
@@ -0,0 +1,32 @@
+// Command timerange provides arithmetic of time ranges.
+//
+// Usage:
+//
+//	timerange [flags] command [arguments...]
+//
+// A range is given in one of the following forms:
+//
+//	[2006-01-02T15:04:05Z, 2006-01-02T16:04:05Z]
+//	2006-01-02T15:04:05Z/2006-01-02T16:04:05Z
+//	2006-01-02T15:04:05Z/PT1H
+//	P1D/20060102T150405Z
+//	2006-01-02..2006-01-05
+//	last 7 days
+//
+// If no range is given in the arguments, ranges are read from the standard input line by line.
+package main
+
+import (
+	"fmt"
+	"os"
+	_ "time/tzdata"
+
+	"github.com/int128/go-timerange"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, timerange.RealClock{}); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "timerange: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestRun(t *testing.T) {
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+
+	for _, c := range []struct {
+		name  string
+		args  []string
+		stdin string
+	}{
+		{"parse", []string{"-tz", "UTC", "parse", "[2006-01-02T15:04:05Z, 2006-01-02T16:04:05Z]", "2006-01-02T15:04:05+09:00/2006-01-02T16:04:05+09:00", "2006-01-01..2006-01-02", "last 2 hours"}, ""},
+		{"parse_iso", []string{"-tz", "UTC", "parse", "2006-01-02T15:04:05Z/PT1H", "P1D/20060102T150405Z", "20060102T150405+0900/20060102T160405+0900"}, ""},
+		{"parse_stdin", []string{"-tz", "UTC", "parse"}, "2006-01-02T15:04:05Z/2006-01-02T16:04:05Z\n\ntoday\n"},
+		{"parse_json", []string{"-tz", "UTC", "-o", "json", "parse", "2006-01-02T15:04:05Z/2006-01-02T16:04:05Z"}, ""},
+		{"intersect", []string{"intersect", "2006-01-02T15:00:00Z/2006-01-02T16:00:00Z", "2006-01-02T15:30:00Z/2006-01-02T17:00:00Z"}, ""},
+		{"intersect_empty", []string{"-o", "json", "intersect", "2006-01-02T15:00:00Z/2006-01-02T16:00:00Z", "2006-01-02T17:00:00Z/2006-01-02T18:00:00Z"}, ""},
+		{"union", []string{"union"}, "2006-01-02T15:30:00Z/2006-01-02T17:00:00Z\n2006-01-02T15:00:00Z/2006-01-02T16:00:00Z\n2006-01-02T18:00:00Z/2006-01-02T19:00:00Z\n"},
+		{"diff", []string{"diff", "2006-01-02T15:00:00Z/2006-01-02T18:00:00Z", "2006-01-02T16:00:00Z/2006-01-02T17:00:00Z"}, ""},
+		{"split", []string{"split", "25m", "2006-01-02T15:00:00Z/2006-01-02T16:00:00Z"}, ""},
+		{"contains", []string{"contains", "2006-01-02T15:30:00Z", "2006-01-02T15:00:00Z/2006-01-02T16:00:00Z", "2006-01-02T17:00:00Z/2006-01-02T18:00:00Z"}, ""},
+		{"contains_json", []string{"-o", "json", "contains", "2006-01-02T15:30:00Z", "2006-01-02T15:00:00Z/2006-01-02T16:00:00Z"}, ""},
+		{"duration", []string{"-tz", "UTC", "duration", "2006-01-02T15:00:00Z/2006-01-02T16:30:00Z", "2006-01-01..2006-01-02"}, ""},
+		{"convert-tz", []string{"convert-tz", "Asia/Tokyo", "2006-01-02T15:00:00Z/2006-01-02T16:00:00Z"}, ""},
+		{"format", []string{"format", "kitchen", "2006-01-02T15:00:00Z/2006-01-02T16:00:00Z"}, ""},
+		{"format_human", []string{"-tz", "UTC", "format", "human", "2006-01-02T15:00:00Z/2006-01-02T16:00:00Z", "2006-01-02..2006-01-04"}, ""},
+		{"format_layout_json", []string{"-o", "json", "format", "Jan 2 15:04", "2006-01-02T15:00:00Z/2006-01-02T16:00:00Z"}, ""},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			if err := run(c.args, strings.NewReader(c.stdin), &stdout, clock); err != nil {
+				t.Fatalf("run error: %s", err)
+			}
+			golden := filepath.Join("testdata", c.name+".golden")
+			if *update {
+				if err := os.WriteFile(golden, stdout.Bytes(), 0644); err != nil {
+					t.Fatalf("could not update golden file: %s", err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("could not read golden file: %s", err)
+			}
+			if diff := cmp.Diff(string(want), stdout.String()); diff != "" {
+				t.Errorf("want != got\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRun_Error(t *testing.T) {
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+
+	for _, c := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"no command", nil, "usage: timerange"},
+		{"unknown command", []string{"foo"}, `unknown command "foo"`},
+		{"invalid range", []string{"parse", "2006-01-02T16:00:00Z/2006-01-02T15:00:00Z"}, "start time is after end time"},
+		{"invalid human range", []string{"parse", "last 2 fortnights"}, `unknown unit: "fortnights" at offset 7`},
+		{"missing span", []string{"split"}, "missing span"},
+		{"not an instant", []string{"contains", "today", "today"}, "is not an instant"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			err := run(c.args, strings.NewReader(""), &stdout, clock)
+			if err == nil {
+				t.Fatalf("want error but was nil")
+			}
+			if !strings.Contains(err.Error(), c.want) {
+				t.Errorf("want error containing %q but was %q", c.want, err)
+			}
+		})
+	}
+}
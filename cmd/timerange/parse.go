@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+// parseRange parses a range in the form of timerange.Parse() or timerange.ParseHuman().
+func parseRange(s string, clock timerange.Clock, loc *time.Location) (timerange.TimeRange, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") || strings.Contains(s, "/") {
+		return timerange.Parse(s)
+	}
+	return timerange.ParseHuman(s, clock, loc)
+}
+
+// parseTime parses an instant in the form of timerange.ParseHuman().
+func parseTime(s string, clock timerange.Clock, loc *time.Location) (time.Time, error) {
+	r, err := timerange.ParseHuman(s, clock, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if r.Duration() != 0 {
+		return time.Time{}, fmt.Errorf("%q is not an instant but %s", s, r)
+	}
+	return r.Start(), nil
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+type command struct {
+	usage string
+	run   func(e *env, args []string) error
+}
+
+var commands = map[string]command{
+	"parse":      {usage: "parse [RANGE...]", run: runParse},
+	"intersect":  {usage: "intersect [RANGE...]", run: runIntersect},
+	"union":      {usage: "union [RANGE...]", run: runUnion},
+	"diff":       {usage: "diff BASE [RANGE...]", run: runDiff},
+	"split":      {usage: "split SPAN [RANGE...]", run: runSplit},
+	"contains":   {usage: "contains TIME [RANGE...]", run: runContains},
+	"duration":   {usage: "duration [RANGE...]", run: runDuration},
+	"convert-tz": {usage: "convert-tz LOCATION [RANGE...]", run: runConvertTZ},
+	"format":     {usage: "format LAYOUT|human [RANGE...]", run: runFormat},
+}
+
+// env represents the environment of a command.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	clock  timerange.Clock
+	loc    *time.Location
+	json   bool
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, clock timerange.Clock) error {
+	fs := flag.NewFlagSet("timerange", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	output := fs.String("o", "text", "Output format (text or json)")
+	tz := fs.String("tz", "Local", "Location to parse and print ranges")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w\n%s", err, usage(fs))
+	}
+	if fs.NArg() == 0 {
+		return errors.New(usage(fs))
+	}
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		return fmt.Errorf("unknown command %q\n%s", fs.Arg(0), usage(fs))
+	}
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		return fmt.Errorf("invalid location: %w", err)
+	}
+	e := &env{stdin: stdin, stdout: stdout, clock: clock, loc: loc}
+	switch *output {
+	case "text":
+	case "json":
+		e.json = true
+	default:
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+	if err := cmd.run(e, fs.Args()[1:]); err != nil {
+		return fmt.Errorf("%s: %w", fs.Arg(0), err)
+	}
+	return nil
+}
+
+func usage(fs *flag.FlagSet) string {
+	var b strings.Builder
+	b.WriteString("usage: timerange [flags] command [arguments...]\n\ncommands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %s\n", commands[name].usage)
+	}
+	b.WriteString("\nranges:\n")
+	b.WriteString("  [START, END]\n")
+	b.WriteString("  START/END, START/DURATION or DURATION/END in ISO 8601, such as 2006-01-02T15:04:05Z/PT1H\n")
+	b.WriteString("  expression such as 2006-01-02..2006-01-05 or \"last 7 days\"\n")
+	b.WriteString("\nflags:\n")
+	fs.VisitAll(func(f *flag.Flag) {
+		fmt.Fprintf(&b, "  -%s\t%s (default %q)\n", f.Name, f.Usage, f.DefValue)
+	})
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// ranges parses the arguments, or the lines of stdin if no argument is given.
+func (e *env) ranges(args []string) ([]timerange.TimeRange, error) {
+	if len(args) == 0 {
+		scanner := bufio.NewScanner(e.stdin)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				args = append(args, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("read stdin: %w", err)
+		}
+	}
+	ranges := make([]timerange.TimeRange, 0, len(args))
+	for _, arg := range args {
+		r, err := parseRange(arg, e.clock, e.loc)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+type jsonRange struct {
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Duration string    `json:"duration"`
+}
+
+func (e *env) writeRanges(ranges []timerange.TimeRange) error {
+	if e.json {
+		values := make([]jsonRange, 0, len(ranges))
+		for _, r := range ranges {
+			values = append(values, jsonRange{Start: r.Start(), End: r.End(), Duration: r.Duration().String()})
+		}
+		return e.writeJSON(values)
+	}
+	lines := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		lines = append(lines, r.String())
+	}
+	return e.writeLines(lines)
+}
+
+func (e *env) writeJSON(v any) error {
+	encoder := json.NewEncoder(e.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (e *env) writeLines(lines []string) error {
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(e.stdout, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
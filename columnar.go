@@ -0,0 +1,337 @@
+package timerange
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"sort"
+	"time"
+)
+
+const columnarVersion byte = 1
+
+// DefaultColumnarBlockSize is the number of ranges in a block if no block size is given.
+const DefaultColumnarBlockSize = 1024
+
+var (
+	minUnixNano = time.Unix(0, math.MinInt64)
+	maxUnixNano = time.Unix(0, math.MaxInt64)
+)
+
+// EncodeColumnar encodes the ranges into a compact columnar form.
+// The ranges must be sorted by start time.
+// Zero values are ignored.
+//
+// The ranges are divided into blocks of blockSize ranges.
+// In each block, the start times are encoded by delta-of-delta and the durations by delta,
+// both in varint.
+// Strictly periodic ranges such as per-minute intervals of the same duration take about 2 bytes per range.
+// If 10% of the durations vary, they take about 2.8 bytes per range.
+// If blockSize is not positive, DefaultColumnarBlockSize is used.
+//
+// Times are encoded in nanoseconds since the Unix epoch,
+// so they must be between the years 1678 and 2262.
+// The location and monotonic clock reading are not encoded.
+func EncodeColumnar(ranges []TimeRange, blockSize int) ([]byte, error) {
+	if blockSize <= 0 {
+		blockSize = DefaultColumnarBlockSize
+	}
+	var index, blocks []byte
+	var n, blockCount int
+	var enc columnarBlockEncoder
+	var prev TimeRange
+	for i, r := range ranges {
+		if r.IsZero() {
+			continue
+		}
+		if r.start.Before(minUnixNano) || r.end.After(maxUnixNano) {
+			return nil, fmt.Errorf("ranges[%d] is out of the encodable range", i)
+		}
+		if !prev.IsZero() && r.start.Before(prev.start) {
+			return nil, fmt.Errorf("ranges[%d] is not sorted by start time", i)
+		}
+		prev = r
+		if n%blockSize == 0 {
+			if blockCount > 0 {
+				index = enc.appendIndex(index)
+			}
+			enc = columnarBlockEncoder{offset: len(blocks)}
+			blockCount++
+		}
+		blocks = enc.append(blocks, r)
+		n++
+	}
+	if blockCount > 0 {
+		index = enc.appendIndex(index)
+	}
+
+	b := make([]byte, 0, 1+3*binary.MaxVarintLen64+len(index)+len(blocks))
+	b = append(b, columnarVersion)
+	b = binary.AppendUvarint(b, uint64(n))
+	b = binary.AppendUvarint(b, uint64(blockSize))
+	b = binary.AppendUvarint(b, uint64(len(index)))
+	b = append(b, index...)
+	return append(b, blocks...), nil
+}
+
+type columnarBlockEncoder struct {
+	offset    int
+	n         int
+	first     int64
+	maxEnd    int64
+	prevStart int64
+	prevDelta uint64
+	prevDur   uint64
+}
+
+func (e *columnarBlockEncoder) append(b []byte, r TimeRange) []byte {
+	start, end := r.start.UnixNano(), r.end.UnixNano()
+	// The duration may exceed math.MaxInt64, but it fits in uint64.
+	dur := uint64(end) - uint64(start)
+	switch e.n {
+	case 0:
+		e.first, e.maxEnd = start, end
+	case 1:
+		delta := uint64(start) - uint64(e.prevStart)
+		b = binary.AppendUvarint(b, delta)
+		e.prevDelta = delta
+	default:
+		delta := uint64(start) - uint64(e.prevStart)
+		b = binary.AppendVarint(b, int64(delta-e.prevDelta))
+		e.prevDelta = delta
+	}
+	b = binary.AppendVarint(b, int64(dur-e.prevDur))
+	e.prevStart, e.prevDur = start, dur
+	e.maxEnd = max(e.maxEnd, end)
+	e.n++
+	return b
+}
+
+func (e *columnarBlockEncoder) appendIndex(b []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(e.offset))
+	b = binary.AppendVarint(b, e.first)
+	return binary.AppendVarint(b, e.maxEnd)
+}
+
+// ColumnarRanges represents the ranges encoded by EncodeColumnar.
+// It decodes a block on demand, so it supports random access without decoding all ranges.
+// The decoded times are in UTC.
+type ColumnarRanges struct {
+	n         int
+	blockSize int
+	blocks    []columnarBlock
+}
+
+type columnarBlock struct {
+	data   []byte
+	first  int64
+	maxEnd int64
+}
+
+// DecodeColumnar decodes the data encoded by EncodeColumnar.
+// It validates all blocks, so the methods of ColumnarRanges never fail.
+// The returned value refers to data, so data must not be modified.
+func DecodeColumnar(data []byte) (*ColumnarRanges, error) {
+	if len(data) == 0 {
+		return nil, errors.New("timerange.DecodeColumnar: no data")
+	}
+	if data[0] != columnarVersion {
+		return nil, errors.New("timerange.DecodeColumnar: unsupported version")
+	}
+	data = data[1:]
+	var header [3]uint64
+	for i := range header {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, errors.New("timerange.DecodeColumnar: invalid header")
+		}
+		header[i] = v
+		data = data[n:]
+	}
+	// Each range takes 1 byte at least.
+	count, blockSize, indexLen := header[0], header[1], header[2]
+	if count > uint64(len(data)) || blockSize == 0 || blockSize > math.MaxInt32 || indexLen > uint64(len(data)) {
+		return nil, errors.New("timerange.DecodeColumnar: invalid header")
+	}
+	blockCount := (count + blockSize - 1) / blockSize
+	// Each index entry takes 3 bytes at least.
+	if blockCount*3 > indexLen {
+		return nil, errors.New("timerange.DecodeColumnar: invalid index")
+	}
+	index, body := data[:indexLen], data[indexLen:]
+
+	c := &ColumnarRanges{n: int(count), blockSize: int(blockSize), blocks: make([]columnarBlock, blockCount)}
+	offsets := make([]uint64, blockCount+1)
+	for i := range c.blocks {
+		offset, n := binary.Uvarint(index)
+		if n <= 0 {
+			return nil, errors.New("timerange.DecodeColumnar: invalid index")
+		}
+		index = index[n:]
+		first, n := binary.Varint(index)
+		if n <= 0 {
+			return nil, errors.New("timerange.DecodeColumnar: invalid index")
+		}
+		index = index[n:]
+		maxEnd, n := binary.Varint(index)
+		if n <= 0 {
+			return nil, errors.New("timerange.DecodeColumnar: invalid index")
+		}
+		index = index[n:]
+		if (i == 0 && offset != 0) || (i > 0 && offset < offsets[i-1]) || offset > uint64(len(body)) {
+			return nil, errors.New("timerange.DecodeColumnar: invalid index")
+		}
+		offsets[i] = offset
+		c.blocks[i] = columnarBlock{first: first, maxEnd: maxEnd}
+	}
+	if len(index) > 0 {
+		return nil, errors.New("timerange.DecodeColumnar: invalid index")
+	}
+	offsets[blockCount] = uint64(len(body))
+
+	var prevStart int64 = math.MinInt64
+	for i := range c.blocks {
+		block := &c.blocks[i]
+		block.data = body[offsets[i]:offsets[i+1]]
+		if block.first < prevStart {
+			return nil, fmt.Errorf("timerange.DecodeColumnar: block %d is not sorted", i)
+		}
+		var maxEnd int64 = math.MinInt64
+		dec := columnarBlockDecoder{block: *block}
+		for range c.blockLen(i) {
+			start, end, ok := dec.next()
+			if !ok || start < prevStart || end < start {
+				return nil, fmt.Errorf("timerange.DecodeColumnar: block %d is corrupted", i)
+			}
+			prevStart, maxEnd = start, max(maxEnd, end)
+		}
+		if len(dec.block.data) > 0 || maxEnd != block.maxEnd {
+			return nil, fmt.Errorf("timerange.DecodeColumnar: block %d is corrupted", i)
+		}
+	}
+	return c, nil
+}
+
+// Len returns the number of ranges.
+func (c *ColumnarRanges) Len() int {
+	return c.n
+}
+
+// At returns the i-th range.
+// It decodes the block containing the range.
+// It panics if i is out of range.
+func (c *ColumnarRanges) At(i int) TimeRange {
+	if i < 0 || i >= c.n {
+		panic(fmt.Sprintf("timerange: index out of range [%d] with length %d", i, c.n))
+	}
+	dec := columnarBlockDecoder{block: c.blocks[i/c.blockSize]}
+	var start, end int64
+	for range i%c.blockSize + 1 {
+		start, end, _ = dec.next()
+	}
+	return newColumnarRange(start, end)
+}
+
+// All returns an iterator over all ranges in order.
+func (c *ColumnarRanges) All() iter.Seq[TimeRange] {
+	return func(yield func(TimeRange) bool) {
+		for i := range c.blocks {
+			if !c.yieldBlock(i, TimeRange{}, yield) {
+				return
+			}
+		}
+	}
+}
+
+// Overlapping returns an iterator over the ranges which overlap with r.
+// It skips the blocks which do not overlap with r.
+func (c *ColumnarRanges) Overlapping(r TimeRange) iter.Seq[TimeRange] {
+	return func(yield func(TimeRange) bool) {
+		if r.IsZero() {
+			return
+		}
+		// The first block whose start time is after r.end
+		last := sort.Search(len(c.blocks), func(i int) bool {
+			return newColumnarTime(c.blocks[i].first).After(r.end)
+		})
+		for i := range c.blocks[:last] {
+			if newColumnarTime(c.blocks[i].maxEnd).Before(r.start) {
+				continue
+			}
+			if !c.yieldBlock(i, r, yield) {
+				return
+			}
+		}
+	}
+}
+
+func (c *ColumnarRanges) yieldBlock(i int, filter TimeRange, yield func(TimeRange) bool) bool {
+	dec := columnarBlockDecoder{block: c.blocks[i]}
+	for range c.blockLen(i) {
+		start, end, _ := dec.next()
+		r := newColumnarRange(start, end)
+		if !filter.IsZero() && Intersect(filter, r).IsZero() {
+			continue
+		}
+		if !yield(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *ColumnarRanges) blockLen(i int) int {
+	return min(c.blockSize, c.n-i*c.blockSize)
+}
+
+type columnarBlockDecoder struct {
+	block     columnarBlock
+	n         int
+	prevStart int64
+	prevDelta uint64
+	prevDur   uint64
+}
+
+func (d *columnarBlockDecoder) next() (start, end int64, ok bool) {
+	switch d.n {
+	case 0:
+		start = d.block.first
+	case 1:
+		delta, n := binary.Uvarint(d.block.data)
+		if n <= 0 {
+			return 0, 0, false
+		}
+		d.block.data = d.block.data[n:]
+		start = int64(uint64(d.prevStart) + delta)
+		d.prevDelta = delta
+	default:
+		dod, n := binary.Varint(d.block.data)
+		if n <= 0 {
+			return 0, 0, false
+		}
+		d.block.data = d.block.data[n:]
+		delta := d.prevDelta + uint64(dod)
+		start = int64(uint64(d.prevStart) + delta)
+		d.prevDelta = delta
+	}
+	ddur, n := binary.Varint(d.block.data)
+	if n <= 0 {
+		return 0, 0, false
+	}
+	d.block.data = d.block.data[n:]
+	dur := d.prevDur + uint64(ddur)
+	end = int64(uint64(start) + dur)
+	d.prevStart, d.prevDur = start, dur
+	d.n++
+	return start, end, true
+}
+
+func newColumnarTime(nsec int64) time.Time {
+	return time.Unix(0, nsec).UTC()
+}
+
+func newColumnarRange(start, end int64) TimeRange {
+	return TimeRange{start: newColumnarTime(start), end: newColumnarTime(end)}
+}
@@ -0,0 +1,253 @@
+package timerange_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+// uptimeRanges returns per-minute ranges with some jitter,
+// which looks like a year of uptime intervals.
+func uptimeRanges(n int) []timerange.TimeRange {
+	rng := rand.New(rand.NewPCG(1, 2))
+	t := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ranges := make([]timerange.TimeRange, 0, n)
+	for range n {
+		d := 50 * time.Second
+		if rng.IntN(10) == 0 {
+			d -= time.Duration(rng.IntN(30)) * time.Second
+		}
+		ranges = append(ranges, timerange.New(t, t.Add(d)))
+		t = t.Add(time.Minute)
+	}
+	return ranges
+}
+
+func TestEncodeColumnar(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	for _, c := range []struct {
+		name      string
+		ranges    []timerange.TimeRange
+		blockSize int
+	}{
+		{"empty", nil, 0},
+		{"one", []timerange.TimeRange{
+			timerange.New(time.Date(2006, 1, 2, 15, 4, 5, 0, jst), time.Date(2006, 1, 2, 15, 7, 5, 0, jst)),
+		}, 0},
+		{"irregular", []timerange.TimeRange{
+			timerange.New(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC)),
+			timerange.New(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC)),
+			timerange.New(time.Date(2006, 1, 2, 15, 5, 0, 1, time.UTC), time.Date(2006, 1, 2, 15, 5, 0, 1, time.UTC)),
+			timerange.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+		}, 3},
+		{"widest", []timerange.TimeRange{
+			timerange.New(time.Unix(0, -1<<63), time.Unix(0, 1<<63-1)),
+			timerange.New(time.Unix(0, 1<<63-1), time.Unix(0, 1<<63-1)),
+		}, 0},
+		{"uptime", uptimeRanges(10000), 0},
+		{"uptime in small blocks", uptimeRanges(100), 7},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			data, err := timerange.EncodeColumnar(c.ranges, c.blockSize)
+			if err != nil {
+				t.Fatalf("EncodeColumnar error: %s", err)
+			}
+			t.Logf("%d ranges in %d bytes", len(c.ranges), len(data))
+			got, err := timerange.DecodeColumnar(data)
+			if err != nil {
+				t.Fatalf("DecodeColumnar error: %s", err)
+			}
+			if want, got := len(c.ranges), got.Len(); want != got {
+				t.Errorf("Len wants %d but was %d", want, got)
+			}
+			if diff := cmp.Diff(c.ranges, slices.Collect(got.All()), equateTimeRange); diff != "" {
+				t.Errorf("All mismatch (-want +got):\n%s", diff)
+			}
+			for i, want := range c.ranges {
+				if got := got.At(i); !want.Equal(got) {
+					t.Errorf("At(%d) wants %v != got %v", i, want, got)
+				}
+			}
+		})
+	}
+
+	t.Run("zero values are ignored", func(t *testing.T) {
+		r := timerange.New(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC))
+		data, err := timerange.EncodeColumnar([]timerange.TimeRange{{}, r, {}}, 0)
+		if err != nil {
+			t.Fatalf("EncodeColumnar error: %s", err)
+		}
+		got, err := timerange.DecodeColumnar(data)
+		if err != nil {
+			t.Fatalf("DecodeColumnar error: %s", err)
+		}
+		if diff := cmp.Diff([]timerange.TimeRange{r}, slices.Collect(got.All()), equateTimeRange); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("not sorted", func(t *testing.T) {
+		_, err := timerange.EncodeColumnar([]timerange.TimeRange{
+			timerange.New(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC)),
+			timerange.New(time.Date(2006, 1, 1, 15, 4, 5, 0, time.UTC), time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC)),
+		}, 0)
+		if err == nil {
+			t.Errorf("want error but was nil")
+		}
+	})
+	t.Run("out of the encodable range", func(t *testing.T) {
+		_, err := timerange.EncodeColumnar([]timerange.TimeRange{
+			timerange.New(time.Date(1000, 1, 2, 15, 4, 5, 0, time.UTC), time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC)),
+		}, 0)
+		if err == nil {
+			t.Errorf("want error but was nil")
+		}
+	})
+}
+
+func TestColumnarRanges_Overlapping(t *testing.T) {
+	ranges := uptimeRanges(1000)
+	data, err := timerange.EncodeColumnar(ranges, 16)
+	if err != nil {
+		t.Fatalf("EncodeColumnar error: %s", err)
+	}
+	c, err := timerange.DecodeColumnar(data)
+	if err != nil {
+		t.Fatalf("DecodeColumnar error: %s", err)
+	}
+	for _, r := range []timerange.TimeRange{
+		{},
+		timerange.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)),
+		timerange.New(time.Date(2026, 1, 1, 0, 0, 55, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 59, 0, time.UTC)),
+		timerange.New(time.Date(2026, 1, 1, 1, 0, 50, 0, time.UTC), time.Date(2026, 1, 1, 3, 30, 0, 0, time.UTC)),
+		timerange.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)),
+	} {
+		t.Run(r.String(), func(t *testing.T) {
+			var want []timerange.TimeRange
+			for _, x := range ranges {
+				if !r.IsZero() && !timerange.Intersect(r, x).IsZero() {
+					want = append(want, x)
+				}
+			}
+			if diff := cmp.Diff(want, slices.Collect(c.Overlapping(r)), equateTimeRange); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDecodeColumnar(t *testing.T) {
+	valid, err := timerange.EncodeColumnar(uptimeRanges(10), 4)
+	if err != nil {
+		t.Fatalf("EncodeColumnar error: %s", err)
+	}
+	for _, c := range []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"unsupported version", append([]byte{2}, valid[1:]...)},
+		{"truncated", valid[:len(valid)-1]},
+		{"trailing bytes", append(slices.Clone(valid), 0)},
+		{"header only", valid[:4]},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := timerange.DecodeColumnar(c.data); err == nil {
+				t.Errorf("want error but was nil")
+			}
+		})
+	}
+}
+
+func FuzzDecodeColumnar(f *testing.F) {
+	for _, ranges := range [][]timerange.TimeRange{nil, uptimeRanges(10)} {
+		data, err := timerange.EncodeColumnar(ranges, 4)
+		if err != nil {
+			f.Fatalf("EncodeColumnar error: %s", err)
+		}
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		c, err := timerange.DecodeColumnar(data)
+		if err != nil {
+			return
+		}
+		ranges := slices.Collect(c.All())
+		if len(ranges) != c.Len() {
+			t.Fatalf("All returned %d ranges but Len was %d", len(ranges), c.Len())
+		}
+		encoded, err := timerange.EncodeColumnar(ranges, 0)
+		if err != nil {
+			t.Fatalf("EncodeColumnar error: %s", err)
+		}
+		decoded, err := timerange.DecodeColumnar(encoded)
+		if err != nil {
+			t.Fatalf("DecodeColumnar error: %s", err)
+		}
+		if diff := cmp.Diff(ranges, slices.Collect(decoded.All()), equateTimeRange); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func BenchmarkEncodeColumnar(b *testing.B) {
+	ranges := uptimeRanges(365 * 24 * 60)
+	b.Run("columnar", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			data, err := timerange.EncodeColumnar(ranges, 0)
+			if err != nil {
+				b.Fatalf("EncodeColumnar error: %s", err)
+			}
+			size = len(data)
+		}
+		b.ReportMetric(float64(size)/float64(len(ranges)), "bytes/range")
+		b.ReportMetric(float64(len(ranges))*float64(b.N)/b.Elapsed().Seconds(), "ranges/s")
+	})
+	b.Run("gob", func(b *testing.B) {
+		var size int
+		for i := 0; i < b.N; i++ {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(ranges); err != nil {
+				b.Fatalf("Encode error: %s", err)
+			}
+			size = buf.Len()
+		}
+		b.ReportMetric(float64(size)/float64(len(ranges)), "bytes/range")
+		b.ReportMetric(float64(len(ranges))*float64(b.N)/b.Elapsed().Seconds(), "ranges/s")
+	})
+}
+
+func BenchmarkDecodeColumnar(b *testing.B) {
+	ranges := uptimeRanges(365 * 24 * 60)
+	data, err := timerange.EncodeColumnar(ranges, 0)
+	if err != nil {
+		b.Fatalf("EncodeColumnar error: %s", err)
+	}
+	b.Run("All", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			c, err := timerange.DecodeColumnar(data)
+			if err != nil {
+				b.Fatalf("DecodeColumnar error: %s", err)
+			}
+			for range c.All() {
+			}
+		}
+		b.ReportMetric(float64(len(ranges))*float64(b.N)/b.Elapsed().Seconds(), "ranges/s")
+	})
+	b.Run("At", func(b *testing.B) {
+		c, err := timerange.DecodeColumnar(data)
+		if err != nil {
+			b.Fatalf("DecodeColumnar error: %s", err)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			c.At(i % c.Len())
+		}
+	})
+}
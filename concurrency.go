@@ -0,0 +1,105 @@
+package timerange
+
+import (
+	"slices"
+	"time"
+)
+
+// OverlapCount represents a step of the number of overlapping ranges.
+type OverlapCount struct {
+	// Range is the range where the number of overlapping ranges is constant.
+	Range TimeRange
+	// Count is the number of ranges which overlap the range.
+	Count int
+}
+
+// OverlapCounts returns the step function of the number of overlapping ranges,
+// from the earliest start time to the latest end time of the ranges.
+// Zero values are ignored.
+//
+// Adjacent steps share their boundary.
+// As ranges include both start and end time, the count at a boundary is the larger one of the adjacent steps.
+// If more ranges overlap only at an instant, for example, a range ends at the time when another range starts,
+// this returns a zero-duration step for the instant.
+func OverlapCounts(ranges []TimeRange) []OverlapCount {
+	type event struct {
+		at    time.Time
+		start bool
+	}
+	var events []event
+	for _, r := range ranges {
+		if r.IsZero() {
+			continue
+		}
+		events = append(events, event{at: r.start, start: true}, event{at: r.end})
+	}
+	slices.SortFunc(events, func(a, b event) int {
+		return a.at.Compare(b.at)
+	})
+
+	var steps []OverlapCount
+	var current int
+	var last time.Time
+	for i := 0; i < len(events); {
+		at := events[i].at
+		var starts, ends int
+		for ; i < len(events) && events[i].at.Equal(at); i++ {
+			if events[i].start {
+				starts++
+			} else {
+				ends++
+			}
+		}
+		before, point, after := current, current+starts, current+starts-ends
+		if len(steps) > 0 || before > 0 {
+			steps = appendOverlapCount(steps, OverlapCount{Range: New(last, at), Count: before})
+		}
+		if point > max(before, after) {
+			steps = append(steps, OverlapCount{Range: New(at, at), Count: point})
+		}
+		current, last = after, at
+	}
+	return steps
+}
+
+func appendOverlapCount(steps []OverlapCount, step OverlapCount) []OverlapCount {
+	if len(steps) > 0 {
+		prev := &steps[len(steps)-1]
+		if prev.Count == step.Count && prev.Range.Duration() > 0 {
+			prev.Range.end = step.Range.end
+			return steps
+		}
+	}
+	return append(steps, step)
+}
+
+// MaxConcurrency returns the maximum number of overlapping ranges,
+// and the ranges where the maximum is reached in ascending order.
+// If no range is given, this returns 0.
+func MaxConcurrency(ranges []TimeRange) (int, []TimeRange) {
+	var maxCount int
+	var at []TimeRange
+	for _, step := range OverlapCounts(ranges) {
+		if step.Count > maxCount {
+			maxCount, at = step.Count, nil
+		}
+		if step.Count == maxCount {
+			at = append(at, step.Range)
+		}
+	}
+	return maxCount, at
+}
+
+// ConcurrentAtLeast returns the ranges where at least k ranges overlap.
+// The result is sorted by start time.
+func ConcurrentAtLeast(ranges []TimeRange, k int) []TimeRange {
+	return slices.Collect(MergeSorted(func(yield func(TimeRange) bool) {
+		for _, step := range OverlapCounts(ranges) {
+			if step.Count >= k {
+				if !yield(step.Range) {
+					return
+				}
+			}
+		}
+	}, 0))
+}
@@ -0,0 +1,173 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+func TestOverlapCounts(t *testing.T) {
+	t.Run("overlapping and separated", func(t *testing.T) {
+		got := timerange.OverlapCounts([]timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 40, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 50, 0, 0, time.UTC),
+			),
+		})
+		want := []timerange.OverlapCount{
+			{
+				Range: timerange.New(
+					time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+					time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+				),
+				Count: 1,
+			},
+			{
+				Range: timerange.New(
+					time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+					time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+				),
+				Count: 2,
+			},
+			{
+				Range: timerange.New(
+					time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+					time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+				),
+				Count: 1,
+			},
+			{
+				Range: timerange.New(
+					time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+					time.Date(2006, 1, 2, 15, 40, 0, 0, time.UTC),
+				),
+				Count: 0,
+			},
+			{
+				Range: timerange.New(
+					time.Date(2006, 1, 2, 15, 40, 0, 0, time.UTC),
+					time.Date(2006, 1, 2, 15, 50, 0, 0, time.UTC),
+				),
+				Count: 1,
+			},
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("touching", func(t *testing.T) {
+		got := timerange.OverlapCounts([]timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+			),
+		})
+		want := []timerange.OverlapCount{
+			{
+				Range: timerange.New(
+					time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+					time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+				),
+				Count: 1,
+			},
+			{
+				Range: timerange.New(
+					time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+					time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+				),
+				Count: 2,
+			},
+			{
+				Range: timerange.New(
+					time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+					time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+				),
+				Count: 1,
+			},
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		got := timerange.OverlapCounts(nil)
+		if len(got) != 0 {
+			t.Errorf("want empty but was %v", got)
+		}
+	})
+}
+
+func TestMaxConcurrency(t *testing.T) {
+	ranges := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 25, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 50, 0, 0, time.UTC),
+		),
+	}
+	gotCount, gotAt := timerange.MaxConcurrency(ranges)
+	if gotCount != 2 {
+		t.Errorf("count wants 2 but was %d", gotCount)
+	}
+	wantAt := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 25, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+		),
+	}
+	if diff := cmp.Diff(wantAt, gotAt, equateTimeRange); diff != "" {
+		t.Errorf("want != got\n%s", diff)
+	}
+}
+
+func TestConcurrentAtLeast(t *testing.T) {
+	ranges := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 40, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 50, 0, 0, time.UTC),
+		),
+	}
+	got := timerange.ConcurrentAtLeast(ranges, 2)
+	want := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 40, 0, 0, time.UTC),
+		),
+	}
+	if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+		t.Errorf("want != got\n%s", diff)
+	}
+}
@@ -0,0 +1,55 @@
+package timerange
+
+import "time"
+
+// Gaps returns the parts of the bounds which are not covered by any of the ranges.
+// The ranges may be unsorted and may overlap each other.
+// Each gap shares its start and end time with the adjacent ranges or the bounds.
+// If the bounds are fully covered, this returns an empty slice.
+func Gaps(bounds TimeRange, ranges []TimeRange) []TimeRange {
+	var gaps []TimeRange
+	cursor := bounds.start
+	for _, r := range Merge(ranges, 0) {
+		if r.end.Before(bounds.start) {
+			continue
+		}
+		if r.start.After(bounds.end) {
+			break
+		}
+		if r.start.After(cursor) {
+			gaps = append(gaps, New(cursor, r.start))
+		}
+		cursor = maxTime(cursor, r.end)
+	}
+	if cursor.Before(bounds.end) {
+		gaps = append(gaps, New(cursor, bounds.end))
+	}
+	return gaps
+}
+
+// Coverage returns the duration of the bounds covered by the ranges,
+// and its ratio to the duration of the bounds between 0 and 1.
+// The ranges may be unsorted and may overlap each other.
+// If the bounds have zero duration, the ratio is 1 if any range contains them, otherwise 0.
+func Coverage(bounds TimeRange, ranges []TimeRange) (time.Duration, float64) {
+	var covered time.Duration
+	var overlapped bool
+	for _, r := range Merge(ranges, 0) {
+		if r.end.Before(bounds.start) {
+			continue
+		}
+		if r.start.After(bounds.end) {
+			break
+		}
+		covered += Intersect(bounds, r).Duration()
+		overlapped = true
+	}
+	total := bounds.Duration()
+	if total == 0 {
+		if overlapped {
+			return 0, 1
+		}
+		return 0, 0
+	}
+	return covered, float64(covered) / float64(total)
+}
@@ -0,0 +1,142 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+func TestGaps(t *testing.T) {
+	bounds := timerange.New(
+		time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+	)
+
+	t.Run("unsorted and overlapping", func(t *testing.T) {
+		got := timerange.Gaps(bounds, []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 40, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 50, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+			),
+		})
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 40, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 50, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("ranges exceed bounds", func(t *testing.T) {
+		got := timerange.Gaps(bounds, []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 14, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 40, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 17, 0, 0, 0, time.UTC),
+			),
+		})
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 40, 0, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("fully covered", func(t *testing.T) {
+		got := timerange.Gaps(bounds, []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+			),
+		})
+		if len(got) != 0 {
+			t.Errorf("want empty but was %v", got)
+		}
+	})
+	t.Run("no ranges", func(t *testing.T) {
+		got := timerange.Gaps(bounds, nil)
+		want := []timerange.TimeRange{bounds}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+}
+
+func TestCoverage(t *testing.T) {
+	bounds := timerange.New(
+		time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+	)
+
+	t.Run("unsorted and overlapping", func(t *testing.T) {
+		covered, ratio := timerange.Coverage(bounds, []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 45, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 17, 0, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+			),
+		})
+		if want := 35 * time.Minute; covered != want {
+			t.Errorf("covered wants %s but was %s", want, covered)
+		}
+		if want := 35.0 / 60.0; ratio != want {
+			t.Errorf("ratio wants %v but was %v", want, ratio)
+		}
+	})
+	t.Run("no ranges", func(t *testing.T) {
+		covered, ratio := timerange.Coverage(bounds, nil)
+		if covered != 0 {
+			t.Errorf("covered wants 0 but was %s", covered)
+		}
+		if ratio != 0 {
+			t.Errorf("ratio wants 0 but was %v", ratio)
+		}
+	})
+	t.Run("zero duration bounds", func(t *testing.T) {
+		point := timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+		)
+		_, ratio := timerange.Coverage(point, []timerange.TimeRange{bounds})
+		if ratio != 1 {
+			t.Errorf("ratio wants 1 but was %v", ratio)
+		}
+	})
+}
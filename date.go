@@ -0,0 +1,122 @@
+package timerange
+
+import (
+	"fmt"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Date represents a civil date without time of day and location.
+// A Date out of range such as February 30 is compared as the normalized date,
+// and NewDate and NewDateRange normalize it.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns a Date.
+// It normalizes the date in the same way as time.Date,
+// for example, October 32 is converted to November 1.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the Date of the time in its location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses a date in the form of "2006-01-02".
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date: %w", err)
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date in the form of "2006-01-02".
+// If this is the zero value, it returns an empty string.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.utc().Format(dateLayout)
+}
+
+// IsZero returns true if this is the zero value.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// In returns the midnight of this date in the location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date after the days.
+// If the days is negative, this returns the earlier date.
+func (d Date) AddDays(days int) Date {
+	return DateOf(d.utc().AddDate(0, 0, days))
+}
+
+// DaysSince returns the number of days from x to this date.
+func (d Date) DaysSince(x Date) int {
+	return int((d.utc().Unix() - x.utc().Unix()) / (24 * 60 * 60))
+}
+
+// Compare returns -1 if this date is before x, +1 if after x, or 0 if the same.
+func (d Date) Compare(x Date) int {
+	return d.utc().Compare(x.utc())
+}
+
+// Before returns true if this date is before x.
+func (d Date) Before(x Date) bool {
+	return d.Compare(x) < 0
+}
+
+// After returns true if this date is after x.
+func (d Date) After(x Date) bool {
+	return d.Compare(x) > 0
+}
+
+// Weekday returns the day of the week.
+func (d Date) Weekday() time.Weekday {
+	return d.utc().Weekday()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty string is decoded to the zero value.
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// normalize returns the date normalized by NewDate, such as February 30 to March 2.
+// The zero value is kept as it is.
+func (d Date) normalize() Date {
+	if d.IsZero() {
+		return d
+	}
+	return NewDate(d.Year, d.Month, d.Day)
+}
+
+func (d Date) utc() time.Time {
+	return d.In(time.UTC)
+}
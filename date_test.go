@@ -0,0 +1,116 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestNewDate(t *testing.T) {
+	got := timerange.NewDate(2026, 10, 32)
+	if want := (timerange.Date{Year: 2026, Month: time.November, Day: 1}); want != got {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	got := timerange.DateOf(time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC).In(jst))
+	if want := timerange.NewDate(2026, 10, 19); want != got {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		got, err := timerange.ParseDate("2026-10-18")
+		if err != nil {
+			t.Fatalf("ParseDate error: %s", err)
+		}
+		if want := timerange.NewDate(2026, 10, 18); want != got {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	for _, s := range []string{"", "2026-10-32", "2026/10/18", "2026-10-18T00:00:00Z"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := timerange.ParseDate(s); err == nil {
+				t.Errorf("want error but was nil")
+			}
+		})
+	}
+}
+
+func TestDate_String(t *testing.T) {
+	got := timerange.NewDate(2026, 1, 2).String()
+	if want := "2026-01-02"; want != got {
+		t.Errorf("want %s but was %s", want, got)
+	}
+	t.Run("zero", func(t *testing.T) {
+		var d timerange.Date
+		if want, got := "", d.String(); want != got {
+			t.Errorf("want %q but was %q", want, got)
+		}
+		var decoded timerange.Date
+		if err := decoded.UnmarshalText([]byte(d.String())); err != nil {
+			t.Fatalf("UnmarshalText error: %s", err)
+		}
+		if !decoded.IsZero() {
+			t.Errorf("want zero but was %v", decoded)
+		}
+	})
+}
+
+func TestDate_In(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	got := timerange.NewDate(2026, 10, 18).In(jst)
+	if want := time.Date(2026, 10, 18, 0, 0, 0, 0, jst); !want.Equal(got) {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
+
+func TestDate_AddDays(t *testing.T) {
+	d := timerange.NewDate(2024, 2, 28)
+	if want, got := timerange.NewDate(2024, 2, 29), d.AddDays(1); want != got {
+		t.Errorf("want %v != got %v", want, got)
+	}
+	if want, got := timerange.NewDate(2023, 12, 31), d.AddDays(-59); want != got {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
+
+func TestDate_DaysSince(t *testing.T) {
+	for _, c := range []struct {
+		d, x timerange.Date
+		want int
+	}{
+		{timerange.NewDate(2026, 10, 18), timerange.NewDate(2026, 10, 18), 0},
+		{timerange.NewDate(2026, 10, 18), timerange.NewDate(2026, 10, 17), 1},
+		{timerange.NewDate(2026, 10, 17), timerange.NewDate(2026, 10, 18), -1},
+		{timerange.NewDate(2025, 1, 1), timerange.NewDate(2024, 1, 1), 366},
+		{timerange.NewDate(2500, 1, 1), timerange.NewDate(1500, 1, 1), 365243},
+	} {
+		if got := c.d.DaysSince(c.x); c.want != got {
+			t.Errorf("%v.DaysSince(%v) wants %d but was %d", c.d, c.x, c.want, got)
+		}
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	a, b := timerange.NewDate(2026, 10, 18), timerange.NewDate(2026, 10, 19)
+	if !a.Before(b) || a.After(b) || a.Compare(b) != -1 {
+		t.Errorf("want %v < %v", a, b)
+	}
+	if !b.After(a) || b.Before(a) || b.Compare(a) != 1 {
+		t.Errorf("want %v > %v", b, a)
+	}
+	if a.Compare(a) != 0 {
+		t.Errorf("want %v == %v", a, a)
+	}
+}
+
+func TestDate_Weekday(t *testing.T) {
+	if want, got := time.Sunday, timerange.NewDate(2026, 10, 18).Weekday(); want != got {
+		t.Errorf("want %v but was %v", want, got)
+	}
+}
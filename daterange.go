@@ -0,0 +1,180 @@
+package timerange
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+)
+
+// NewDateRange returns a DateRange with start date and end date.
+// The dates are normalized as well as NewDate, such as February 30 to March 2.
+// It must be start <= end.
+// If start > end, this returns a zero value.
+func NewDateRange(start, end Date) DateRange {
+	start, end = start.normalize(), end.normalize()
+	if start.After(end) {
+		return DateRange{}
+	}
+	return DateRange{start: start, end: end}
+}
+
+// ParseDateRange parses a range in the form of "2006-01-02/2006-01-05".
+func ParseDateRange(s string) (DateRange, error) {
+	startText, endText, ok := strings.Cut(s, "/")
+	if !ok {
+		return DateRange{}, fmt.Errorf("invalid date range %q: missing separator /", s)
+	}
+	start, err := ParseDate(startText)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("invalid start of date range %q: %w", s, err)
+	}
+	end, err := ParseDate(endText)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("invalid end of date range %q: %w", s, err)
+	}
+	if start.After(end) {
+		return DateRange{}, fmt.Errorf("invalid date range %q: start date is after end date", s)
+	}
+	return NewDateRange(start, end), nil
+}
+
+// DateRange represents an immutable range of civil dates, such as holidays or billing periods.
+// The range includes start date and end date, i.e., [start, end].
+type DateRange struct {
+	start Date
+	end   Date
+}
+
+// Start returns the start date.
+func (r DateRange) Start() Date {
+	return r.start
+}
+
+// End returns the end date.
+func (r DateRange) End() Date {
+	return r.end
+}
+
+// String returns a string representation in the form of "2006-01-02/2006-01-05".
+// If this is a zero value, it returns an empty string as well as MarshalText.
+func (r DateRange) String() string {
+	if r.IsZero() {
+		return ""
+	}
+	return r.start.String() + "/" + r.end.String()
+}
+
+// IsZero returns true if both start date and end date are zero value.
+func (r DateRange) IsZero() bool {
+	return r.start.IsZero() && r.end.IsZero()
+}
+
+// Equal returns true if this range is equivalent to one.
+func (r DateRange) Equal(x DateRange) bool {
+	return r == x
+}
+
+// Contains returns true if the date is within this range.
+func (r DateRange) Contains(d Date) bool {
+	if r.IsZero() {
+		return false
+	}
+	return !d.Before(r.start) && !d.After(r.end)
+}
+
+// Days returns the number of days in this range, including both start date and end date.
+// If this is a zero value, it returns 0.
+func (r DateRange) Days() int {
+	if r.IsZero() {
+		return 0
+	}
+	return r.end.DaysSince(r.start) + 1
+}
+
+// All returns an iterator over each date in this range.
+func (r DateRange) All() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if r.IsZero() {
+			return
+		}
+		for d := r.start; !d.After(r.end); d = d.AddDays(1) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// Intersect returns the range of dates in both ranges.
+// If the ranges do not overlap, this returns a zero value.
+func (r DateRange) Intersect(x DateRange) DateRange {
+	if r.IsZero() || x.IsZero() {
+		return DateRange{}
+	}
+	start, end := r.start, r.end
+	if x.start.After(start) {
+		start = x.start
+	}
+	if x.end.Before(end) {
+		end = x.end
+	}
+	return NewDateRange(start, end)
+}
+
+// Union returns the range of dates in either range.
+// If the ranges neither overlap nor are adjacent, this returns false.
+// A zero value is ignored.
+func (r DateRange) Union(x DateRange) (DateRange, bool) {
+	switch {
+	case r.IsZero():
+		return x, !x.IsZero()
+	case x.IsZero():
+		return r, true
+	}
+	if r.start.After(x.end.AddDays(1)) || x.start.After(r.end.AddDays(1)) {
+		return DateRange{}, false
+	}
+	start, end := r.start, r.end
+	if x.start.Before(start) {
+		start = x.start
+	}
+	if x.end.After(end) {
+		end = x.end
+	}
+	return NewDateRange(start, end), true
+}
+
+// TimeRange returns a TimeRange of this range in the location.
+// It starts at the midnight of the start date
+// and ends at the midnight of the day after the end date.
+// If this is a zero value, it returns a zero value.
+func (r DateRange) TimeRange(loc *time.Location) TimeRange {
+	if r.IsZero() {
+		return TimeRange{}
+	}
+	return New(r.start.In(loc), r.end.AddDays(1).In(loc))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It returns the form of "2006-01-02/2006-01-05",
+// which is also used as a JSON string.
+// If this is a zero value, it returns an empty string.
+func (r DateRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty string is decoded to a zero value.
+func (r *DateRange) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = DateRange{}
+		return nil
+	}
+	parsed, err := ParseDateRange(string(text))
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
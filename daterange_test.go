@@ -0,0 +1,242 @@
+package timerange_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+func TestNewDateRange(t *testing.T) {
+	t.Run("start < end", func(t *testing.T) {
+		r := timerange.NewDateRange(timerange.NewDate(2026, 10, 18), timerange.NewDate(2026, 10, 20))
+		if want, got := timerange.NewDate(2026, 10, 18), r.Start(); want != got {
+			t.Errorf("Start wants %v != got %v", want, got)
+		}
+		if want, got := timerange.NewDate(2026, 10, 20), r.End(); want != got {
+			t.Errorf("End wants %v != got %v", want, got)
+		}
+	})
+	t.Run("start > end", func(t *testing.T) {
+		r := timerange.NewDateRange(timerange.NewDate(2026, 10, 20), timerange.NewDate(2026, 10, 18))
+		if !r.IsZero() {
+			t.Errorf("want zero but was %v", r)
+		}
+	})
+	t.Run("not normalized", func(t *testing.T) {
+		r := timerange.NewDateRange(timerange.Date{Year: 2026, Month: 2, Day: 30}, timerange.Date{Year: 2026, Month: 3, Day: 32})
+		want := timerange.NewDateRange(timerange.NewDate(2026, 3, 2), timerange.NewDate(2026, 4, 1))
+		if !want.Equal(r) {
+			t.Errorf("want %v != got %v", want, r)
+		}
+		r = timerange.NewDateRange(timerange.Date{Year: 2026, Month: 2, Day: 30}, timerange.Date{Year: 2026, Month: 2, Day: 30})
+		want = timerange.NewDateRange(timerange.NewDate(2026, 3, 2), timerange.NewDate(2026, 3, 2))
+		if !want.Equal(r) {
+			t.Errorf("want %v != got %v", want, r)
+		}
+		if !r.Contains(timerange.NewDate(2026, 3, 2)) {
+			t.Errorf("%v does not contain 2026-03-02", r)
+		}
+	})
+	t.Run("zero", func(t *testing.T) {
+		var r timerange.DateRange
+		if want, got := "", r.String(); want != got {
+			t.Errorf("want %q but was %q", want, got)
+		}
+	})
+}
+
+func TestParseDateRange(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		got, err := timerange.ParseDateRange("2026-10-18/2026-10-20")
+		if err != nil {
+			t.Fatalf("ParseDateRange error: %s", err)
+		}
+		want := timerange.NewDateRange(timerange.NewDate(2026, 10, 18), timerange.NewDate(2026, 10, 20))
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	for _, s := range []string{"", "2026-10-18", "2026-10-18/", "x/2026-10-20", "2026-10-20/2026-10-18"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := timerange.ParseDateRange(s); err == nil {
+				t.Errorf("want error but was nil")
+			}
+		})
+	}
+}
+
+func TestDateRange_Contains(t *testing.T) {
+	r := timerange.NewDateRange(timerange.NewDate(2026, 10, 18), timerange.NewDate(2026, 10, 20))
+	for _, c := range []struct {
+		d    timerange.Date
+		want bool
+	}{
+		{timerange.NewDate(2026, 10, 17), false},
+		{timerange.NewDate(2026, 10, 18), true},
+		{timerange.NewDate(2026, 10, 19), true},
+		{timerange.NewDate(2026, 10, 20), true},
+		{timerange.NewDate(2026, 10, 21), false},
+	} {
+		if got := r.Contains(c.d); c.want != got {
+			t.Errorf("Contains(%v) wants %v but was %v", c.d, c.want, got)
+		}
+	}
+	if (timerange.DateRange{}).Contains(timerange.Date{}) {
+		t.Errorf("zero value must not contain any date")
+	}
+}
+
+func TestDateRange_Days(t *testing.T) {
+	for _, c := range []struct {
+		r    timerange.DateRange
+		want int
+	}{
+		{timerange.DateRange{}, 0},
+		{timerange.NewDateRange(timerange.NewDate(2026, 10, 18), timerange.NewDate(2026, 10, 18)), 1},
+		{timerange.NewDateRange(timerange.NewDate(2024, 2, 1), timerange.NewDate(2024, 2, 29)), 29},
+		{timerange.NewDateRange(timerange.NewDate(2026, 1, 1), timerange.NewDate(2026, 12, 31)), 365},
+	} {
+		if got := c.r.Days(); c.want != got {
+			t.Errorf("%v: want %d but was %d", c.r, c.want, got)
+		}
+	}
+}
+
+func TestDateRange_All(t *testing.T) {
+	r := timerange.NewDateRange(timerange.NewDate(2026, 12, 30), timerange.NewDate(2027, 1, 2))
+	want := []timerange.Date{
+		timerange.NewDate(2026, 12, 30),
+		timerange.NewDate(2026, 12, 31),
+		timerange.NewDate(2027, 1, 1),
+		timerange.NewDate(2027, 1, 2),
+	}
+	if diff := cmp.Diff(want, slices.Collect(r.All())); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if got := slices.Collect(timerange.DateRange{}.All()); len(got) != 0 {
+		t.Errorf("want empty but was %v", got)
+	}
+}
+
+func TestDateRange_Intersect(t *testing.T) {
+	newDateRange := func(startDay, endDay int) timerange.DateRange {
+		return timerange.NewDateRange(timerange.NewDate(2026, 10, startDay), timerange.NewDate(2026, 10, endDay))
+	}
+	for _, c := range []struct {
+		name string
+		a, b timerange.DateRange
+		want timerange.DateRange
+	}{
+		{"overlap", newDateRange(1, 10), newDateRange(5, 15), newDateRange(5, 10)},
+		{"contain", newDateRange(1, 10), newDateRange(3, 4), newDateRange(3, 4)},
+		{"one day", newDateRange(1, 10), newDateRange(10, 15), newDateRange(10, 10)},
+		{"adjacent", newDateRange(1, 10), newDateRange(11, 15), timerange.DateRange{}},
+		{"zero", newDateRange(1, 10), timerange.DateRange{}, timerange.DateRange{}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.a.Intersect(c.b); !c.want.Equal(got) {
+				t.Errorf("want %v != got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestDateRange_Union(t *testing.T) {
+	newDateRange := func(startDay, endDay int) timerange.DateRange {
+		return timerange.NewDateRange(timerange.NewDate(2026, 10, startDay), timerange.NewDate(2026, 10, endDay))
+	}
+	for _, c := range []struct {
+		name   string
+		a, b   timerange.DateRange
+		want   timerange.DateRange
+		wantOK bool
+	}{
+		{"overlap", newDateRange(1, 10), newDateRange(5, 15), newDateRange(1, 15), true},
+		{"contain", newDateRange(1, 10), newDateRange(3, 4), newDateRange(1, 10), true},
+		{"adjacent", newDateRange(11, 15), newDateRange(1, 10), newDateRange(1, 15), true},
+		{"separated", newDateRange(1, 10), newDateRange(12, 15), timerange.DateRange{}, false},
+		{"zero", timerange.DateRange{}, newDateRange(1, 10), newDateRange(1, 10), true},
+		{"both zero", timerange.DateRange{}, timerange.DateRange{}, timerange.DateRange{}, false},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := c.a.Union(c.b)
+			if c.wantOK != ok {
+				t.Errorf("ok wants %v but was %v", c.wantOK, ok)
+			}
+			if !c.want.Equal(got) {
+				t.Errorf("want %v != got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestDateRange_TimeRange(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %s", err)
+	}
+	r := timerange.NewDateRange(timerange.NewDate(2026, 11, 1), timerange.NewDate(2026, 11, 2))
+	got := r.TimeRange(newYork)
+	want := timerange.New(
+		time.Date(2026, 11, 1, 0, 0, 0, 0, newYork),
+		time.Date(2026, 11, 3, 0, 0, 0, 0, newYork),
+	)
+	if !want.Equal(got) {
+		t.Errorf("want %v != got %v", want, got)
+	}
+	// 2026-11-01 has 25 hours in New York
+	if want, got := 49*time.Hour, got.Duration(); want != got {
+		t.Errorf("Duration wants %v but was %v", want, got)
+	}
+	if got := (timerange.DateRange{}).TimeRange(newYork); !got.IsZero() {
+		t.Errorf("want zero but was %v", got)
+	}
+}
+
+func TestDateRange_MarshalText(t *testing.T) {
+	type vacation struct {
+		Period timerange.DateRange `json:"period"`
+	}
+	r := timerange.NewDateRange(timerange.NewDate(2026, 10, 18), timerange.NewDate(2026, 10, 20))
+	b, err := json.Marshal(vacation{Period: r})
+	if err != nil {
+		t.Fatalf("json.Marshal error: %s", err)
+	}
+	if want, got := `{"period":"2026-10-18/2026-10-20"}`, string(b); want != got {
+		t.Errorf("want %s but was %s", want, got)
+	}
+	var decoded vacation
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal error: %s", err)
+	}
+	if !r.Equal(decoded.Period) {
+		t.Errorf("want %v != got %v", r, decoded.Period)
+	}
+
+	t.Run("zero", func(t *testing.T) {
+		b, err := json.Marshal(vacation{})
+		if err != nil {
+			t.Fatalf("json.Marshal error: %s", err)
+		}
+		if want, got := `{"period":""}`, string(b); want != got {
+			t.Errorf("want %s but was %s", want, got)
+		}
+		var decoded vacation
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatalf("json.Unmarshal error: %s", err)
+		}
+		if !decoded.Period.IsZero() {
+			t.Errorf("want zero but was %v", decoded.Period)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		var decoded vacation
+		if err := json.Unmarshal([]byte(`{"period":"2026-10-20/2026-10-18"}`), &decoded); err == nil {
+			t.Errorf("want error but was nil")
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package timerange
+
+import "time"
+
+// Distance returns the duration of the gap between the ranges.
+// If the ranges overlap or touch each other, this returns 0.
+func Distance(a, b TimeRange) time.Duration {
+	switch {
+	case a.end.Before(b.start):
+		return b.start.Sub(a.end)
+	case b.end.Before(a.start):
+		return a.start.Sub(b.end)
+	}
+	return 0
+}
+
+// DistanceTo returns the duration between the time and this range.
+// If the time is within this range, this returns 0.
+func (r TimeRange) DistanceTo(t time.Time) time.Duration {
+	switch {
+	case t.Before(r.start):
+		return r.start.Sub(t)
+	case t.After(r.end):
+		return t.Sub(r.end)
+	}
+	return 0
+}
+
+// OverlapDuration returns the duration of the intersection of the ranges.
+// If the ranges do not overlap or only touch each other, this returns 0.
+func OverlapDuration(a, b TimeRange) time.Duration {
+	return Intersect(a, b).Duration()
+}
+
+// OverlapRatio returns the Jaccard index of the ranges,
+// that is, the duration of the intersection divided by the duration of the union.
+// It is 1 if the ranges are equal, and 0 if they do not overlap or only touch each other.
+// If both ranges have zero length, this returns 1 if they are at the same time, otherwise 0.
+func OverlapRatio(a, b TimeRange) float64 {
+	overlap := float64(OverlapDuration(a, b))
+	union := float64(a.Duration()) + float64(b.Duration()) - overlap
+	if union == 0 {
+		if a.start.Equal(b.start) {
+			return 1
+		}
+		return 0
+	}
+	return overlap / union
+}
@@ -0,0 +1,92 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func newTestRange(startHour, startMinute, endHour, endMinute int) timerange.TimeRange {
+	return timerange.New(
+		time.Date(2006, 1, 2, startHour, startMinute, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, endHour, endMinute, 0, 0, time.UTC),
+	)
+}
+
+var distanceTestCases = []struct {
+	name         string
+	a, b         timerange.TimeRange
+	distance     time.Duration
+	overlap      time.Duration
+	overlapRatio float64
+}{
+	{"separated", newTestRange(10, 0, 11, 0), newTestRange(11, 30, 12, 0), 30 * time.Minute, 0, 0},
+	{"touching", newTestRange(10, 0, 11, 0), newTestRange(11, 0, 12, 0), 0, 0, 0},
+	{"overlapping", newTestRange(10, 0, 11, 0), newTestRange(10, 30, 12, 0), 0, 30 * time.Minute, 0.25},
+	{"nested", newTestRange(10, 0, 12, 0), newTestRange(10, 30, 11, 0), 0, 30 * time.Minute, 0.25},
+	{"equal", newTestRange(10, 0, 11, 0), newTestRange(10, 0, 11, 0), 0, time.Hour, 1},
+	{"same point", newTestRange(10, 0, 10, 0), newTestRange(10, 0, 10, 0), 0, 0, 1},
+	{"different points", newTestRange(10, 0, 10, 0), newTestRange(10, 5, 10, 5), 5 * time.Minute, 0, 0},
+	{"point within range", newTestRange(10, 0, 11, 0), newTestRange(10, 30, 10, 30), 0, 0, 0},
+}
+
+func TestDistance(t *testing.T) {
+	for _, c := range distanceTestCases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := timerange.Distance(c.a, c.b); c.distance != got {
+				t.Errorf("want %v but was %v", c.distance, got)
+			}
+			if got := timerange.Distance(c.b, c.a); c.distance != got {
+				t.Errorf("reversed: want %v but was %v", c.distance, got)
+			}
+		})
+	}
+}
+
+func TestOverlapDuration(t *testing.T) {
+	for _, c := range distanceTestCases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := timerange.OverlapDuration(c.a, c.b); c.overlap != got {
+				t.Errorf("want %v but was %v", c.overlap, got)
+			}
+			if got := timerange.OverlapDuration(c.b, c.a); c.overlap != got {
+				t.Errorf("reversed: want %v but was %v", c.overlap, got)
+			}
+		})
+	}
+}
+
+func TestOverlapRatio(t *testing.T) {
+	for _, c := range distanceTestCases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := timerange.OverlapRatio(c.a, c.b); c.overlapRatio != got {
+				t.Errorf("want %v but was %v", c.overlapRatio, got)
+			}
+			if got := timerange.OverlapRatio(c.b, c.a); c.overlapRatio != got {
+				t.Errorf("reversed: want %v but was %v", c.overlapRatio, got)
+			}
+		})
+	}
+}
+
+func TestTimeRange_DistanceTo(t *testing.T) {
+	r := newTestRange(10, 0, 11, 0)
+	for _, c := range []struct {
+		name string
+		t    time.Time
+		want time.Duration
+	}{
+		{"before", time.Date(2006, 1, 2, 9, 45, 0, 0, time.UTC), 15 * time.Minute},
+		{"start", r.Start(), 0},
+		{"within", time.Date(2006, 1, 2, 10, 30, 0, 0, time.UTC), 0},
+		{"end", r.End(), 0},
+		{"after", time.Date(2006, 1, 2, 11, 0, 1, 0, time.UTC), time.Second},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := r.DistanceTo(c.t); c.want != got {
+				t.Errorf("want %v but was %v", c.want, got)
+			}
+		})
+	}
+}
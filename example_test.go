@@ -2,6 +2,7 @@ package timerange_test
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/int128/go-timerange"
@@ -129,3 +130,224 @@ func ExampleTimeRange_SplitIterator() {
 	// 2006-01-02 15:06:05 +0000 UTC
 	// 2006-01-02 15:07:05 +0000 UTC
 }
+
+func ExampleMerge() {
+	ranges := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 5, 30, 0, time.UTC),
+		),
+	}
+	for _, r := range timerange.Merge(ranges, 0) {
+		fmt.Println(r)
+	}
+	// output:
+	// [2006-01-02T15:04:05Z, 2006-01-02T15:05:30Z]
+	// [2006-01-02T15:06:05Z, 2006-01-02T15:07:05Z]
+}
+
+func ExampleGaps() {
+	bounds := timerange.New(
+		time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+	)
+	uptime := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+		),
+	}
+	for _, r := range timerange.Gaps(bounds, uptime) {
+		fmt.Println(r)
+	}
+	// output: [2006-01-02T15:20:00Z, 2006-01-02T15:30:00Z]
+}
+
+func ExampleCoverage() {
+	bounds := timerange.New(
+		time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+	)
+	uptime := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 45, 0, 0, time.UTC),
+		),
+	}
+	covered, ratio := timerange.Coverage(bounds, uptime)
+	fmt.Printf("%s (%.0f%%)", covered, ratio*100)
+	// output: 45m0s (75%)
+}
+
+func ExampleIntersectAll() {
+	alice := timerange.New(
+		time.Date(2006, 1, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 12, 0, 0, 0, time.UTC),
+	)
+	bob := timerange.New(
+		time.Date(2006, 1, 2, 10, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+	)
+	carol := timerange.New(
+		time.Date(2006, 1, 2, 11, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 17, 0, 0, 0, time.UTC),
+	)
+	if r, ok := timerange.IntersectAll(alice, bob, carol); ok {
+		fmt.Print(r)
+	}
+	// output: [2006-01-02T11:00:00Z, 2006-01-02T12:00:00Z]
+}
+
+func ExampleSpan() {
+	a := timerange.New(
+		time.Date(2006, 1, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 12, 0, 0, 0, time.UTC),
+	)
+	b := timerange.New(
+		time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 17, 0, 0, 0, time.UTC),
+	)
+	if r, ok := timerange.Span(a, b); ok {
+		fmt.Print(r)
+	}
+	// output: [2006-01-02T09:00:00Z, 2006-01-02T17:00:00Z]
+}
+
+func ExampleMaxConcurrency() {
+	jobs := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+		),
+	}
+	count, at := timerange.MaxConcurrency(jobs)
+	fmt.Println(count, at)
+	// output: 2 [[2006-01-02T15:10:00Z, 2006-01-02T15:20:00Z]]
+}
+
+func ExampleBucketer() {
+	b := timerange.NewBucketer(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC), 15*time.Minute)
+	event := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	fmt.Println(b.Index(event), b.BucketOf(event))
+	// output: 60 [2006-01-02T15:00:00Z, 2006-01-02T15:15:00Z]
+}
+
+func ExampleSessionWindow_Sessions() {
+	clicks := []time.Time{
+		time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 5, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+	}
+	w := timerange.SessionWindow{Timeout: 30 * time.Minute}
+	for session := range w.Sessions(slices.Values(clicks)) {
+		fmt.Println(session)
+	}
+	// output:
+	// [2006-01-02T15:00:00Z, 2006-01-02T15:05:00Z]
+	// [2006-01-02T16:00:00Z, 2006-01-02T16:00:00Z]
+}
+
+func ExampleLast() {
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+	fmt.Print(timerange.Last(clock, 24*time.Hour))
+	// output: [2006-01-01T15:04:05Z, 2006-01-02T15:04:05Z]
+}
+
+func ExampleToday() {
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+	fmt.Print(timerange.Today(clock, time.UTC))
+	// output: [2006-01-02T00:00:00Z, 2006-01-03T00:00:00Z]
+}
+
+func ExampleParseHuman() {
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+	r, err := timerange.ParseHuman("last 7 days", clock, time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(r)
+	// output: [2005-12-26T15:04:05Z, 2006-01-02T15:04:05Z]
+}
+
+func ExampleFormatter_Format() {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 4, 0, 0, time.UTC),
+	)
+	fmt.Println(timerange.DefaultFormatter.Format(r))
+	fmt.Println(timerange.DefaultFormatter.FormatDuration(r.Duration()))
+	// output:
+	// Jan 2, 2006 15:04–16:04 UTC
+	// 1h
+}
+
+func ExampleLocale() {
+	r := timerange.New(
+		time.Date(2026, 10, 18, 15, 4, 0, 0, time.UTC),
+		time.Date(2026, 10, 18, 16, 4, 0, 0, time.UTC),
+	)
+	fmt.Println(timerange.Locale("ja").Format(r))
+	fmt.Println(timerange.Locale("de").Format(r))
+	// output:
+	// 2026年10月18日 15:04〜16:04
+	// 18. Okt. 2026, 15:04–16:04 UTC
+}
+
+func ExampleTimeRange_Format() {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	fmt.Printf("%v\n", r)
+	fmt.Printf("%+v\n", r)
+	fmt.Printf("%#v\n", r)
+	// output:
+	// [2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]
+	// [2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z] (duration 3m0s, location UTC)
+	// timerange.New(time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC), time.Date(2006, time.January, 2, 15, 7, 5, 0, time.UTC))
+}
+
+func ExampleDateRange() {
+	r, err := timerange.ParseDateRange("2026-12-30/2027-01-02")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(r.Days())
+	fmt.Println(r.Contains(timerange.NewDate(2027, 1, 1)))
+	fmt.Println(r.TimeRange(time.UTC))
+	// output:
+	// 4
+	// true
+	// [2026-12-30T00:00:00Z, 2027-01-03T00:00:00Z]
+}
+
+func ExampleWeeklySchedule() {
+	jst := time.FixedZone("JST", 9*60*60)
+	s, err := timerange.ParseWeeklySchedule("Mo-Fr 09:00-18:00; Sa 10:00-14:00", jst)
+	if err != nil {
+		panic(err)
+	}
+	now := time.Date(2026, 10, 23, 19, 0, 0, 0, jst)
+	next, _ := s.NextOpen(now)
+	fmt.Println(s.IsOpen(now))
+	fmt.Println(next)
+	// output:
+	// false
+	// 2026-10-24 10:00:00 +0900 JST
+}
@@ -0,0 +1,172 @@
+package timerange
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Names is a table of month and weekday names.
+// If a name is empty, the English name is used.
+type Names struct {
+	Months        [12]string
+	ShortMonths   [12]string
+	Weekdays      [7]string
+	ShortWeekdays [7]string
+}
+
+// format returns a textual representation of the time in the layout of time.Time.Format().
+// It replaces the names of month and weekday in the layout with the names in this table.
+func (n Names) format(t time.Time, layout string) string {
+	var b strings.Builder
+	for i := 0; i < len(layout); {
+		token, name := n.nameToken(t, layout[i:])
+		if token == "" {
+			i++
+			continue
+		}
+		b.WriteString(t.Format(layout[:i]))
+		if name == "" {
+			name = t.Format(token)
+		}
+		b.WriteString(name)
+		layout = layout[i+len(token):]
+		i = 0
+	}
+	b.WriteString(t.Format(layout))
+	return b.String()
+}
+
+// nameToken returns the name token at the head of the layout and its name in this table.
+// As well as time.Time.Format(), "Jan" and "Mon" followed by a lowercase letter are not tokens,
+// such as "Monat".
+func (n Names) nameToken(t time.Time, layout string) (string, string) {
+	switch {
+	case strings.HasPrefix(layout, "January"):
+		return "January", n.Months[t.Month()-1]
+	case strings.HasPrefix(layout, "Jan") && !startsWithLowerCase(layout[3:]):
+		return "Jan", n.ShortMonths[t.Month()-1]
+	case strings.HasPrefix(layout, "Monday"):
+		return "Monday", n.Weekdays[t.Weekday()]
+	case strings.HasPrefix(layout, "Mon") && !startsWithLowerCase(layout[3:]):
+		return "Mon", n.ShortWeekdays[t.Weekday()]
+	}
+	return "", ""
+}
+
+func startsWithLowerCase(s string) bool {
+	return len(s) > 0 && 'a' <= s[0] && s[0] <= 'z'
+}
+
+// RangeLayout represents the layouts of start time and end time of a range.
+type RangeLayout struct {
+	Start     string
+	Separator string
+	End       string
+}
+
+// Formatter formats a range in a compact human-readable form.
+// It elides the parts of the end time which are same as the start time.
+//
+// Each layout is in the form of time.Time.Format(),
+// and the names of month and weekday are replaced with Names.
+type Formatter struct {
+	Names Names
+
+	// Instant is the layout of a range which starts and ends at the same time.
+	Instant string
+	// Date is the layout of a range of a whole day.
+	Date string
+	// SameDay is the layouts of a range within a day.
+	SameDay RangeLayout
+	// SameMonth is the layouts of a range of whole days within a month.
+	SameMonth RangeLayout
+	// SameYear is the layouts of a range of whole days within a year.
+	SameYear RangeLayout
+	// Dates is the layouts of a range of whole days across years.
+	Dates RangeLayout
+	// DateTimes is the layouts of a range across days.
+	DateTimes RangeLayout
+
+	// DurationUnits is the units of days, hours, minutes and seconds.
+	DurationUnits [4]string
+	// DurationSeparator is the separator between units of a duration.
+	DurationSeparator string
+}
+
+// DefaultFormatter formats a range in English.
+var DefaultFormatter = Formatter{
+	Instant:           "Jan 2, 2006 15:04 MST",
+	Date:              "Jan 2, 2006",
+	SameDay:           RangeLayout{Start: "Jan 2, 2006 15:04", Separator: "–", End: "15:04 MST"},
+	SameMonth:         RangeLayout{Start: "Jan 2", Separator: " – ", End: "2, 2006"},
+	SameYear:          RangeLayout{Start: "Jan 2", Separator: " – ", End: "Jan 2, 2006"},
+	Dates:             RangeLayout{Start: "Jan 2, 2006", Separator: " – ", End: "Jan 2, 2006"},
+	DateTimes:         RangeLayout{Start: "Jan 2, 2006 15:04", Separator: " – ", End: "Jan 2, 2006 15:04 MST"},
+	DurationUnits:     [4]string{"d", "h", "m", "s"},
+	DurationSeparator: " ",
+}
+
+// Format returns a compact human-readable representation of the range.
+// The end time is converted to the location of the start time.
+//
+// If both start time and end time are midnight, the range is treated as whole days,
+// where the last day is the day before the end time.
+// For example, [Oct 18 00:00, Oct 21 00:00] is formatted as "Oct 18 – 20, 2026".
+func (f Formatter) Format(r TimeRange) string {
+	start, end := r.start, r.end.In(r.start.Location())
+	switch {
+	case start.Equal(end):
+		return f.Names.format(start, f.Instant)
+	case isMidnight(start) && isMidnight(end):
+		last := end.AddDate(0, 0, -1)
+		switch {
+		case sameDate(start, last):
+			return f.Names.format(start, f.Date)
+		case start.Year() == last.Year() && start.Month() == last.Month():
+			return f.formatRange(start, last, f.SameMonth)
+		case start.Year() == last.Year():
+			return f.formatRange(start, last, f.SameYear)
+		}
+		return f.formatRange(start, last, f.Dates)
+	case sameDate(start, end):
+		return f.formatRange(start, end, f.SameDay)
+	}
+	return f.formatRange(start, end, f.DateTimes)
+}
+
+func (f Formatter) formatRange(start, end time.Time, layout RangeLayout) string {
+	return f.Names.format(start, layout.Start) + layout.Separator + f.Names.format(end, layout.End)
+}
+
+// FormatDuration returns a compact human-readable representation of the duration,
+// such as "3h 15m".
+// A duration shorter than a second is formatted by time.Duration.String().
+func (f Formatter) FormatDuration(d time.Duration) string {
+	// Negate in uint64, because -d overflows for math.MinInt64
+	sign, rest := "", uint64(d)
+	if d < 0 {
+		sign, rest = "-", -rest
+	}
+	if rest < uint64(time.Second) {
+		return d.String()
+	}
+	var parts []string
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if n := rest / uint64(unit); n > 0 {
+			parts = append(parts, strconv.FormatUint(n, 10)+f.DurationUnits[i])
+			rest -= n * uint64(unit)
+		}
+	}
+	return sign + strings.Join(parts, f.DurationSeparator)
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
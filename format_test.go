@@ -0,0 +1,143 @@
+package timerange_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestFormatter_Format(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+
+	for _, c := range []struct {
+		name string
+		r    timerange.TimeRange
+		want string
+	}{
+		{
+			name: "instant",
+			r: timerange.New(
+				time.Date(2026, 10, 18, 15, 4, 0, 0, jst),
+				time.Date(2026, 10, 18, 15, 4, 0, 0, jst),
+			),
+			want: "Oct 18, 2026 15:04 JST",
+		},
+		{
+			name: "same day",
+			r: timerange.New(
+				time.Date(2026, 10, 18, 15, 4, 0, 0, jst),
+				time.Date(2026, 10, 18, 16, 4, 0, 0, jst),
+			),
+			want: "Oct 18, 2026 15:04–16:04 JST",
+		},
+		{
+			name: "end in another location",
+			r: timerange.New(
+				time.Date(2026, 10, 18, 15, 4, 0, 0, jst),
+				time.Date(2026, 10, 18, 7, 4, 0, 0, time.UTC),
+			),
+			want: "Oct 18, 2026 15:04–16:04 JST",
+		},
+		{
+			name: "whole day",
+			r: timerange.New(
+				time.Date(2026, 10, 18, 0, 0, 0, 0, jst),
+				time.Date(2026, 10, 19, 0, 0, 0, 0, jst),
+			),
+			want: "Oct 18, 2026",
+		},
+		{
+			name: "whole days in a month",
+			r: timerange.New(
+				time.Date(2026, 10, 18, 0, 0, 0, 0, jst),
+				time.Date(2026, 10, 21, 0, 0, 0, 0, jst),
+			),
+			want: "Oct 18 – 20, 2026",
+		},
+		{
+			name: "whole days in a year",
+			r: timerange.New(
+				time.Date(2026, 10, 18, 0, 0, 0, 0, jst),
+				time.Date(2026, 11, 3, 0, 0, 0, 0, jst),
+			),
+			want: "Oct 18 – Nov 2, 2026",
+		},
+		{
+			name: "whole days across years",
+			r: timerange.New(
+				time.Date(2026, 12, 30, 0, 0, 0, 0, jst),
+				time.Date(2027, 1, 3, 0, 0, 0, 0, jst),
+			),
+			want: "Dec 30, 2026 – Jan 2, 2027",
+		},
+		{
+			name: "across days",
+			r: timerange.New(
+				time.Date(2026, 10, 18, 15, 4, 0, 0, jst),
+				time.Date(2026, 10, 19, 16, 4, 0, 0, jst),
+			),
+			want: "Oct 18, 2026 15:04 – Oct 19, 2026 16:04 JST",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			got := timerange.DefaultFormatter.Format(c.r)
+			if c.want != got {
+				t.Errorf("want %q but was %q", c.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatter_Format_Names(t *testing.T) {
+	f := timerange.DefaultFormatter
+	f.Names.ShortWeekdays = [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."}
+	f.Names.Months = [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"}
+	f.Date = "Mon 2 January 2006"
+	r := timerange.New(
+		time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+	)
+	got := f.Format(r)
+	want := "dim. 18 octobre 2026"
+	if want != got {
+		t.Errorf("want %q but was %q", want, got)
+	}
+	t.Run("literal followed by lowercase", func(t *testing.T) {
+		f := f
+		f.Names.ShortMonths = [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sep.", "Okt.", "Nov.", "Dez."}
+		f.Date = "Monat: Jan, Januar 2006"
+		got := f.Format(r)
+		if want := "Monat: Okt., Januar 2026"; want != got {
+			t.Errorf("want %q but was %q", want, got)
+		}
+		// Same tokens as time.Time.Format
+		if want, got := "Monat: Oct, Januar 2026", r.Start().Format(f.Date); want != got {
+			t.Errorf("time.Time.Format wants %q but was %q", want, got)
+		}
+	})
+}
+
+func TestFormatter_FormatDuration(t *testing.T) {
+	for _, c := range []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{500 * time.Millisecond, "500ms"},
+		{3*time.Hour + 15*time.Minute, "3h 15m"},
+		{26*time.Hour + 5*time.Second, "1d 2h 5s"},
+		{-90 * time.Minute, "-1h 30m"},
+		{-500 * time.Millisecond, "-500ms"},
+		{math.MaxInt64, "106751d 23h 47m 16s"},
+		{math.MinInt64, "-106751d 23h 47m 16s"},
+	} {
+		t.Run(c.want, func(t *testing.T) {
+			got := timerange.DefaultFormatter.FormatDuration(c.d)
+			if c.want != got {
+				t.Errorf("want %q but was %q", c.want, got)
+			}
+		})
+	}
+}
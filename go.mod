@@ -1,6 +1,6 @@
 module github.com/int128/go-timerange
 
-go 1.21
+go 1.23
 
 toolchain go1.26.5
 
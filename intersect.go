@@ -1,6 +1,10 @@
 package timerange
 
-import "time"
+import (
+	"iter"
+	"slices"
+	"time"
+)
 
 // Intersect returns the intersection of given ranges.
 // If the intersection is empty, this returns a zero value.
@@ -11,6 +15,61 @@ func Intersect(a, b TimeRange) TimeRange {
 	)
 }
 
+// IntersectAll returns the intersection of all the given ranges.
+// If no range is given, the intersection is empty or any range is a zero value,
+// this returns false.
+func IntersectAll(ranges ...TimeRange) (TimeRange, bool) {
+	return IntersectSeq(slices.Values(ranges))
+}
+
+// IntersectSeq returns the intersection of all the ranges in the sequence.
+// It stops reading the sequence as soon as the intersection becomes empty.
+// See IntersectAll for details.
+func IntersectSeq(ranges iter.Seq[TimeRange]) (TimeRange, bool) {
+	var intersection TimeRange
+	var found bool
+	for r := range ranges {
+		if r.IsZero() {
+			return TimeRange{}, false
+		}
+		if !found {
+			intersection, found = r, true
+			continue
+		}
+		start, end := maxTime(intersection.start, r.start), minTime(intersection.end, r.end)
+		if start.After(end) {
+			return TimeRange{}, false
+		}
+		intersection = TimeRange{start: start, end: end}
+	}
+	return intersection, found
+}
+
+// Span returns the smallest range which covers all the given ranges.
+// Zero values are ignored.
+// If no range is given, this returns false.
+func Span(ranges ...TimeRange) (TimeRange, bool) {
+	return SpanSeq(slices.Values(ranges))
+}
+
+// SpanSeq returns the smallest range which covers all the ranges in the sequence.
+// See Span for details.
+func SpanSeq(ranges iter.Seq[TimeRange]) (TimeRange, bool) {
+	var span TimeRange
+	var found bool
+	for r := range ranges {
+		if r.IsZero() {
+			continue
+		}
+		if !found {
+			span, found = r, true
+			continue
+		}
+		span = TimeRange{start: minTime(span.start, r.start), end: maxTime(span.end, r.end)}
+	}
+	return span, found
+}
+
 func minTime(a, b time.Time) time.Time {
 	if a.Before(b) {
 		return a
@@ -1,6 +1,7 @@
 package timerange_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
@@ -101,3 +102,148 @@ func TestIntersect(t *testing.T) {
 		}
 	})
 }
+
+func TestIntersectAll(t *testing.T) {
+	a := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	b := timerange.New(
+		time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 8, 5, 0, time.UTC),
+	)
+	c := timerange.New(
+		time.Date(2006, 1, 2, 15, 3, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+	)
+	d := timerange.New(
+		time.Date(2006, 1, 2, 16, 5, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 6, 5, 0, time.UTC),
+	)
+
+	t.Run("overlapping", func(t *testing.T) {
+		got, ok := timerange.IntersectAll(a, b, c)
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+		)
+		if !ok {
+			t.Fatalf("want ok but was not ok")
+		}
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("single", func(t *testing.T) {
+		got, ok := timerange.IntersectAll(a)
+		if !ok {
+			t.Fatalf("want ok but was not ok")
+		}
+		if !a.Equal(got) {
+			t.Errorf("want %v != got %v", a, got)
+		}
+	})
+	t.Run("empty intersection", func(t *testing.T) {
+		got, ok := timerange.IntersectAll(a, b, d)
+		if ok {
+			t.Errorf("want not ok but was %v", got)
+		}
+	})
+	t.Run("zero value", func(t *testing.T) {
+		got, ok := timerange.IntersectAll(a, timerange.TimeRange{})
+		if ok {
+			t.Errorf("want not ok but was %v", got)
+		}
+	})
+	t.Run("no range", func(t *testing.T) {
+		got, ok := timerange.IntersectAll()
+		if ok {
+			t.Errorf("want not ok but was %v", got)
+		}
+	})
+}
+
+func TestIntersectSeq(t *testing.T) {
+	ranges := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 16, 5, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 16, 6, 5, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+		),
+	}
+	var read int
+	seq := func(yield func(timerange.TimeRange) bool) {
+		for _, r := range ranges {
+			read++
+			if !yield(r) {
+				return
+			}
+		}
+	}
+	got, ok := timerange.IntersectSeq(seq)
+	if ok {
+		t.Errorf("want not ok but was %v", got)
+	}
+	if read != 2 {
+		t.Errorf("want 2 ranges read but was %d", read)
+	}
+}
+
+func TestSpan(t *testing.T) {
+	a := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	b := timerange.New(
+		time.Date(2006, 1, 2, 16, 5, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 6, 5, 0, time.UTC),
+	)
+
+	t.Run("separated", func(t *testing.T) {
+		got, ok := timerange.Span(b, timerange.TimeRange{}, a)
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 16, 6, 5, 0, time.UTC),
+		)
+		if !ok {
+			t.Fatalf("want ok but was not ok")
+		}
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("no range", func(t *testing.T) {
+		got, ok := timerange.Span()
+		if ok {
+			t.Errorf("want not ok but was %v", got)
+		}
+	})
+}
+
+func TestSpanSeq(t *testing.T) {
+	ranges := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+		),
+	}
+	got, ok := timerange.SpanSeq(slices.Values(ranges))
+	want := ranges[0]
+	if !ok {
+		t.Fatalf("want ok but was not ok")
+	}
+	if !want.Equal(got) {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
@@ -0,0 +1,113 @@
+package timerange
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Formatter{
+		"en": DefaultFormatter,
+		"ja": japaneseFormatter,
+		"de": germanFormatter,
+		"fr": frenchFormatter,
+	}
+)
+
+var japaneseFormatter = Formatter{
+	Names: Names{
+		Months:        [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		ShortMonths:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Weekdays:      [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		ShortWeekdays: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	},
+	Instant:           "2006年1月2日 15:04",
+	Date:              "2006年1月2日",
+	SameDay:           RangeLayout{Start: "2006年1月2日 15:04", Separator: "〜", End: "15:04"},
+	SameMonth:         RangeLayout{Start: "2006年1月2日", Separator: "〜", End: "2日"},
+	SameYear:          RangeLayout{Start: "2006年1月2日", Separator: "〜", End: "1月2日"},
+	Dates:             RangeLayout{Start: "2006年1月2日", Separator: "〜", End: "2006年1月2日"},
+	DateTimes:         RangeLayout{Start: "2006年1月2日 15:04", Separator: "〜", End: "2006年1月2日 15:04"},
+	DurationUnits:     [4]string{"日", "時間", "分", "秒"},
+	DurationSeparator: "",
+}
+
+var germanFormatter = Formatter{
+	Names: Names{
+		Months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		ShortMonths:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		Weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		ShortWeekdays: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+	},
+	Instant:           "2. Jan 2006, 15:04 MST",
+	Date:              "2. Jan 2006",
+	SameDay:           RangeLayout{Start: "2. Jan 2006, 15:04", Separator: "–", End: "15:04 MST"},
+	SameMonth:         RangeLayout{Start: "2.", Separator: "–", End: "2. Jan 2006"},
+	SameYear:          RangeLayout{Start: "2. Jan", Separator: " – ", End: "2. Jan 2006"},
+	Dates:             RangeLayout{Start: "2. Jan 2006", Separator: " – ", End: "2. Jan 2006"},
+	DateTimes:         RangeLayout{Start: "2. Jan 2006, 15:04", Separator: " – ", End: "2. Jan 2006, 15:04 MST"},
+	DurationUnits:     [4]string{" Tg.", " Std.", " Min.", " Sek."},
+	DurationSeparator: " ",
+}
+
+var frenchFormatter = Formatter{
+	Names: Names{
+		Months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		ShortMonths:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		ShortWeekdays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	},
+	Instant:           "2 Jan 2006 15:04 MST",
+	Date:              "2 Jan 2006",
+	SameDay:           RangeLayout{Start: "2 Jan 2006 15:04", Separator: "–", End: "15:04 MST"},
+	SameMonth:         RangeLayout{Start: "2", Separator: "–", End: "2 Jan 2006"},
+	SameYear:          RangeLayout{Start: "2 Jan", Separator: " – ", End: "2 Jan 2006"},
+	Dates:             RangeLayout{Start: "2 Jan 2006", Separator: " – ", End: "2 Jan 2006"},
+	DateTimes:         RangeLayout{Start: "2 Jan 2006 15:04", Separator: " – ", End: "2 Jan 2006 15:04 MST"},
+	DurationUnits:     [4]string{" j", " h", " min", " s"},
+	DurationSeparator: " ",
+}
+
+// RegisterLocale registers the Formatter for the language tag, such as "en" or "pt-BR".
+// If the tag is already registered, this replaces it.
+// It is safe for concurrent use.
+//
+// The following locales are built in: en, ja, de and fr.
+func RegisterLocale(tag string, f Formatter) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[normalizeLocaleTag(tag)] = f
+}
+
+// LookupLocale returns the Formatter for the language tag.
+// If the tag has a region such as "de-AT" and it is not registered,
+// this falls back to the language such as "de".
+// If no Formatter is found, this returns false.
+func LookupLocale(tag string) (Formatter, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	tag = normalizeLocaleTag(tag)
+	if f, ok := locales[tag]; ok {
+		return f, true
+	}
+	if language, _, ok := strings.Cut(tag, "-"); ok {
+		if f, ok := locales[language]; ok {
+			return f, true
+		}
+	}
+	return Formatter{}, false
+}
+
+// Locale returns the Formatter for the language tag.
+// If no Formatter is found, this returns DefaultFormatter.
+func Locale(tag string) Formatter {
+	if f, ok := LookupLocale(tag); ok {
+		return f
+	}
+	return DefaultFormatter
+}
+
+func normalizeLocaleTag(tag string) string {
+	return strings.ReplaceAll(strings.ToLower(tag), "_", "-")
+}
@@ -0,0 +1,132 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+var localeTestRanges = map[string]timerange.TimeRange{
+	"same day": timerange.New(
+		time.Date(2026, 10, 18, 15, 4, 0, 0, time.UTC),
+		time.Date(2026, 10, 18, 16, 4, 0, 0, time.UTC),
+	),
+	"whole day": timerange.New(
+		time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+	),
+	"same month": timerange.New(
+		time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC),
+	),
+	"same year": timerange.New(
+		time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC),
+	),
+	"across years": timerange.New(
+		time.Date(2026, 12, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 3, 0, 0, 0, 0, time.UTC),
+	),
+	"across days": timerange.New(
+		time.Date(2026, 10, 18, 15, 4, 0, 0, time.UTC),
+		time.Date(2026, 10, 19, 16, 4, 0, 0, time.UTC),
+	),
+}
+
+func testLocale(t *testing.T, tag string, wants map[string]string, wantDuration string) {
+	f, ok := timerange.LookupLocale(tag)
+	if !ok {
+		t.Fatalf("locale %s is not found", tag)
+	}
+	for name, want := range wants {
+		t.Run(name, func(t *testing.T) {
+			got := f.Format(localeTestRanges[name])
+			if want != got {
+				t.Errorf("want %q but was %q", want, got)
+			}
+		})
+	}
+	t.Run("duration", func(t *testing.T) {
+		got := f.FormatDuration(26*time.Hour + 15*time.Minute)
+		if wantDuration != got {
+			t.Errorf("want %q but was %q", wantDuration, got)
+		}
+	})
+}
+
+func TestLocale_en(t *testing.T) {
+	testLocale(t, "en", map[string]string{
+		"same day":     "Oct 18, 2026 15:04–16:04 UTC",
+		"whole day":    "Oct 18, 2026",
+		"same month":   "Oct 18 – 20, 2026",
+		"same year":    "Oct 18 – Nov 2, 2026",
+		"across years": "Dec 30, 2026 – Jan 2, 2027",
+		"across days":  "Oct 18, 2026 15:04 – Oct 19, 2026 16:04 UTC",
+	}, "1d 2h 15m")
+}
+
+func TestLocale_ja(t *testing.T) {
+	testLocale(t, "ja", map[string]string{
+		"same day":     "2026年10月18日 15:04〜16:04",
+		"whole day":    "2026年10月18日",
+		"same month":   "2026年10月18日〜20日",
+		"same year":    "2026年10月18日〜11月2日",
+		"across years": "2026年12月30日〜2027年1月2日",
+		"across days":  "2026年10月18日 15:04〜2026年10月19日 16:04",
+	}, "1日2時間15分")
+}
+
+func TestLocale_de(t *testing.T) {
+	testLocale(t, "de", map[string]string{
+		"same day":     "18. Okt. 2026, 15:04–16:04 UTC",
+		"whole day":    "18. Okt. 2026",
+		"same month":   "18.–20. Okt. 2026",
+		"same year":    "18. Okt. – 2. Nov. 2026",
+		"across years": "30. Dez. 2026 – 2. Jan. 2027",
+		"across days":  "18. Okt. 2026, 15:04 – 19. Okt. 2026, 16:04 UTC",
+	}, "1 Tg. 2 Std. 15 Min.")
+}
+
+func TestLocale_fr(t *testing.T) {
+	testLocale(t, "fr", map[string]string{
+		"same day":     "18 oct. 2026 15:04–16:04 UTC",
+		"whole day":    "18 oct. 2026",
+		"same month":   "18–20 oct. 2026",
+		"same year":    "18 oct. – 2 nov. 2026",
+		"across years": "30 déc. 2026 – 2 janv. 2027",
+		"across days":  "18 oct. 2026 15:04 – 19 oct. 2026 16:04 UTC",
+	}, "1 j 2 h 15 min")
+}
+
+func TestLookupLocale(t *testing.T) {
+	t.Run("region falls back to language", func(t *testing.T) {
+		f, ok := timerange.LookupLocale("de_AT")
+		if !ok {
+			t.Fatalf("want ok but was not ok")
+		}
+		got := f.Format(localeTestRanges["whole day"])
+		if want := "18. Okt. 2026"; want != got {
+			t.Errorf("want %q but was %q", want, got)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		if _, ok := timerange.LookupLocale("xx"); ok {
+			t.Errorf("want not ok but was ok")
+		}
+		got := timerange.Locale("xx").Format(localeTestRanges["whole day"])
+		if want := "Oct 18, 2026"; want != got {
+			t.Errorf("want %q but was %q", want, got)
+		}
+	})
+}
+
+func TestRegisterLocale(t *testing.T) {
+	f := timerange.DefaultFormatter
+	f.Date = "2 Jan 2006"
+	timerange.RegisterLocale("en-GB", f)
+	got := timerange.Locale("en-gb").Format(localeTestRanges["whole day"])
+	if want := "18 Oct 2026"; want != got {
+		t.Errorf("want %q but was %q", want, got)
+	}
+}
@@ -0,0 +1,55 @@
+package timerange
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// Merge returns the minimal set of ranges which covers the given ranges.
+// Ranges which overlap or touch each other are merged into one range.
+// If the gap is positive, ranges separated by the gap or less are merged as well.
+// Zero values are ignored.
+// The result is sorted by start time.
+func Merge(ranges []TimeRange, gap time.Duration) []TimeRange {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, compareStart)
+	return slices.Collect(MergeSorted(slices.Values(sorted), gap))
+}
+
+// MergeSorted returns an iterator which merges the ranges in the same way as Merge.
+// The ranges must be sorted by start time.
+// This holds only one range at a time, so it can merge a large stream of ranges.
+func MergeSorted(ranges iter.Seq[TimeRange], gap time.Duration) iter.Seq[TimeRange] {
+	gap = max(gap, 0)
+	return func(yield func(TimeRange) bool) {
+		var current TimeRange
+		for r := range ranges {
+			if r.IsZero() {
+				continue
+			}
+			if current.IsZero() {
+				current = r
+				continue
+			}
+			if r.start.After(current.end.Add(gap)) {
+				if !yield(current) {
+					return
+				}
+				current = r
+				continue
+			}
+			current.end = maxTime(current.end, r.end)
+		}
+		if !current.IsZero() {
+			yield(current)
+		}
+	}
+}
+
+func compareStart(a, b TimeRange) int {
+	if c := a.start.Compare(b.start); c != 0 {
+		return c
+	}
+	return a.end.Compare(b.end)
+}
@@ -0,0 +1,200 @@
+package timerange_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+var equateTimeRange = cmp.Comparer(func(a, b timerange.TimeRange) bool {
+	return a.Equal(b)
+})
+
+func TestMerge(t *testing.T) {
+	t.Run("overlapping", func(t *testing.T) {
+		got := timerange.Merge([]timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			),
+		}, 0)
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("nested", func(t *testing.T) {
+		got := timerange.Merge([]timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			),
+		}, 0)
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("touching", func(t *testing.T) {
+		got := timerange.Merge([]timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			),
+		}, 0)
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("separated", func(t *testing.T) {
+		got := timerange.Merge([]timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			),
+		}, 0)
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("separated within gap", func(t *testing.T) {
+		got := timerange.Merge([]timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			),
+		}, time.Minute)
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("zero value", func(t *testing.T) {
+		got := timerange.Merge([]timerange.TimeRange{
+			{},
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			),
+		}, 0)
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		got := timerange.Merge(nil, 0)
+		if len(got) != 0 {
+			t.Errorf("want empty but was %v", got)
+		}
+	})
+}
+
+func TestMergeSorted(t *testing.T) {
+	ranges := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+		),
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 8, 5, 0, time.UTC),
+		),
+	}
+
+	t.Run("all", func(t *testing.T) {
+		got := slices.Collect(timerange.MergeSorted(slices.Values(ranges), 0))
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 8, 5, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("break", func(t *testing.T) {
+		var got []timerange.TimeRange
+		for r := range timerange.MergeSorted(slices.Values(ranges), 0) {
+			got = append(got, r)
+			break
+		}
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+}
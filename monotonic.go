@@ -0,0 +1,54 @@
+package timerange
+
+import "time"
+
+// Stopwatch measures an elapsed interval.
+// If the clock returns a time with the monotonic clock reading, such as RealClock,
+// the interval is not affected by changes of the system clock.
+type Stopwatch struct {
+	clock Clock
+	start time.Time
+}
+
+// StartStopwatch starts a Stopwatch at the current time of the clock.
+func StartStopwatch(clock Clock) Stopwatch {
+	return Stopwatch{clock: clock, start: clock.Now()}
+}
+
+// Elapsed returns the duration since the start.
+func (s Stopwatch) Elapsed() time.Duration {
+	return s.clock.Now().Sub(s.start)
+}
+
+// Stop returns a TimeRange from the start to the current time of the clock.
+// If the current time is before the start, such as the clock is set back, this returns a zero value.
+func (s Stopwatch) Stop() TimeRange {
+	return New(s.start, s.clock.Now())
+}
+
+// Measure calls the function and returns a TimeRange while it runs.
+func Measure(clock Clock, f func()) TimeRange {
+	s := StartStopwatch(clock)
+	f()
+	return s.Stop()
+}
+
+// IsMonotonic returns true if both start time and end time have the monotonic clock readings.
+//
+// New, Shift and Extend keep the monotonic clock readings as time.Time.Add does.
+// ShiftDate, ExtendDate and the methods with Period strip them as time.Time.AddDate does.
+// Converting the location by time.Time.In strips them as well.
+func (r TimeRange) IsMonotonic() bool {
+	return hasMonotonic(r.start) && hasMonotonic(r.end)
+}
+
+// StripMonotonic returns a TimeRange without the monotonic clock readings.
+// The returned range is compared by the wall clock.
+func (r TimeRange) StripMonotonic() TimeRange {
+	return TimeRange{start: r.start.Round(0), end: r.end.Round(0)}
+}
+
+func hasMonotonic(t time.Time) bool {
+	// Round(0) strips only the monotonic clock reading.
+	return t != t.Round(0)
+}
@@ -0,0 +1,152 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestStopwatch(t *testing.T) {
+	t.Run("fake clock", func(t *testing.T) {
+		clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+		s := timerange.StartStopwatch(clock)
+		clock.Advance(3 * time.Second)
+		if want, got := 3*time.Second, s.Elapsed(); want != got {
+			t.Errorf("Elapsed wants %v but was %v", want, got)
+		}
+		clock.Advance(2 * time.Second)
+		got := s.Stop()
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 4, 10, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+		if got.IsMonotonic() {
+			t.Errorf("want wall clock only but was monotonic")
+		}
+	})
+	t.Run("clock set back", func(t *testing.T) {
+		clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+		s := timerange.StartStopwatch(clock)
+		clock.Advance(-time.Second)
+		if got := s.Stop(); !got.IsZero() {
+			t.Errorf("want zero but was %v", got)
+		}
+	})
+	t.Run("real clock", func(t *testing.T) {
+		got := timerange.Measure(timerange.RealClock{}, func() {
+			time.Sleep(time.Millisecond)
+		})
+		if !got.IsMonotonic() {
+			t.Errorf("want monotonic but was not")
+		}
+		if got.Duration() < time.Millisecond {
+			t.Errorf("Duration wants >= 1ms but was %v", got.Duration())
+		}
+	})
+}
+
+func TestTimeRange_IsMonotonic(t *testing.T) {
+	now := time.Now()
+	r := timerange.New(now, now.Add(time.Hour))
+	if !r.IsMonotonic() {
+		t.Fatalf("want monotonic but was not")
+	}
+	for _, c := range []struct {
+		name string
+		r    timerange.TimeRange
+		want bool
+	}{
+		{"Shift", r.Shift(time.Minute), true},
+		{"Extend", r.Extend(time.Minute), true},
+		{"ShiftDate", r.ShiftDate(0, 0, 1), false},
+		{"ExtendDate", r.ExtendDate(0, 0, 1), false},
+		{"ShiftPeriod", r.ShiftPeriod(timerange.Period{Duration: time.Minute}), false},
+		{"StripMonotonic", r.StripMonotonic(), false},
+		{"wall clock end", timerange.New(now, now.Add(time.Hour).Round(0)), false},
+		{"In", timerange.New(now.In(time.UTC), now.Add(time.Hour).In(time.UTC)), false},
+		{"zero", timerange.TimeRange{}, false},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.r.IsMonotonic(); c.want != got {
+				t.Errorf("want %v but was %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeRange_StripMonotonic(t *testing.T) {
+	now := time.Now()
+	r := timerange.New(now, now.Add(time.Hour))
+	stripped := r.StripMonotonic()
+	// The wall clock readings are the same as the monotonic ones,
+	// so the comparisons give the same results.
+	if !r.Equal(stripped) {
+		t.Errorf("want %v == %v", r, stripped)
+	}
+	if !stripped.Contains(now) {
+		t.Errorf("want %v contains %v", stripped, now)
+	}
+	if got := timerange.Intersect(r, stripped); !got.Equal(r) {
+		t.Errorf("want %v != got %v", r, got)
+	}
+	if r.Duration() != stripped.Duration() {
+		t.Errorf("Duration wants %v but was %v", r.Duration(), stripped.Duration())
+	}
+}
+
+func TestTimeRange_monotonicAndWall(t *testing.T) {
+	now := time.Now()
+	r := timerange.New(now, now.Add(time.Hour))
+	// A wall-only time is compared with r by the wall clock
+	wall := now.Round(0)
+
+	t.Run("Equal", func(t *testing.T) {
+		x := timerange.New(wall, wall.Add(time.Hour))
+		if !r.Equal(x) {
+			t.Errorf("want %v == %v", r, x)
+		}
+		if y := x.Shift(time.Nanosecond); r.Equal(y) {
+			t.Errorf("want %v != %v", r, y)
+		}
+	})
+	t.Run("Contains", func(t *testing.T) {
+		for _, c := range []struct {
+			t    time.Time
+			want bool
+		}{
+			{wall, true},
+			{wall.Add(time.Nanosecond), true},
+			{wall.Add(-time.Nanosecond), false},
+			{wall.Add(time.Hour), true},
+			{wall.Add(time.Hour + time.Nanosecond), false},
+		} {
+			if got := r.Contains(c.t); c.want != got {
+				t.Errorf("Contains(%v) wants %v but was %v", c.t, c.want, got)
+			}
+		}
+	})
+	t.Run("Intersect", func(t *testing.T) {
+		x := timerange.New(wall.Add(time.Nanosecond), wall.Add(2*time.Hour))
+		got := timerange.Intersect(r, x)
+		want := timerange.New(wall.Add(time.Nanosecond), wall.Add(time.Hour))
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+		if got.IsMonotonic() {
+			t.Errorf("want the start of the wall-only range but was monotonic")
+		}
+		if want, got := time.Hour-time.Nanosecond, got.Duration(); want != got {
+			t.Errorf("Duration wants %v but was %v", want, got)
+		}
+	})
+	t.Run("Duration", func(t *testing.T) {
+		mixed := timerange.New(now, wall.Add(time.Hour))
+		if want, got := time.Hour, mixed.Duration(); want != got {
+			t.Errorf("want %v but was %v", want, got)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package timerange
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// isoTimeLayouts are the layouts of times in an ISO 8601 interval.
+// A fraction of seconds is accepted as well as time.Parse.
+var isoTimeLayouts = []string{
+	time.RFC3339,
+	"20060102T150405Z0700",
+}
+
+// Parse parses a range in the following forms:
+//
+//   - "[start, end]" as String returns, such as "[2006-01-02T15:04:05Z, 2006-01-02T16:04:05Z]".
+//   - An ISO 8601 time interval of "start/end", "start/duration" or "duration/end",
+//     such as "2006-01-02T15:04:05Z/PT1H".
+//     The duration is in the form of ParsePeriod.
+//
+// The times are in RFC3339, or the ISO 8601 basic format such as "20060102T150405Z".
+// If the start time is after the end time, this returns an error.
+func Parse(s string) (TimeRange, error) {
+	s = strings.TrimSpace(s)
+	if inner, ok := strings.CutPrefix(s, "["); ok {
+		inner, ok = strings.CutSuffix(inner, "]")
+		if !ok {
+			return TimeRange{}, fmt.Errorf("invalid range %q: missing ]", s)
+		}
+		startText, endText, ok := strings.Cut(inner, ",")
+		if !ok {
+			return TimeRange{}, fmt.Errorf("invalid range %q: missing ,", s)
+		}
+		return parseTimes(s, startText, endText)
+	}
+	startText, endText, ok := strings.Cut(s, "/")
+	if !ok {
+		return TimeRange{}, fmt.Errorf("invalid range %q: want [start, end] or ISO 8601 interval", s)
+	}
+	startText, endText = strings.TrimSpace(startText), strings.TrimSpace(endText)
+	switch {
+	case isPeriodText(startText) && isPeriodText(endText):
+		return TimeRange{}, fmt.Errorf("invalid range %q: both are durations", s)
+	case isPeriodText(startText):
+		p, err := ParsePeriod(startText)
+		if err != nil {
+			return TimeRange{}, fmt.Errorf("invalid duration in %q: %w", s, err)
+		}
+		end, err := parseISOTime(endText)
+		if err != nil {
+			return TimeRange{}, fmt.Errorf("invalid end time in %q: %w", s, err)
+		}
+		return newParsedRange(s, p.Negate().AddTo(end), end)
+	case isPeriodText(endText):
+		start, err := parseISOTime(startText)
+		if err != nil {
+			return TimeRange{}, fmt.Errorf("invalid start time in %q: %w", s, err)
+		}
+		p, err := ParsePeriod(endText)
+		if err != nil {
+			return TimeRange{}, fmt.Errorf("invalid duration in %q: %w", s, err)
+		}
+		return newParsedRange(s, start, p.AddTo(start))
+	}
+	return parseTimes(s, startText, endText)
+}
+
+func parseTimes(s, startText, endText string) (TimeRange, error) {
+	start, err := parseISOTime(startText)
+	if err != nil {
+		return TimeRange{}, fmt.Errorf("invalid start time in %q: %w", s, err)
+	}
+	end, err := parseISOTime(endText)
+	if err != nil {
+		return TimeRange{}, fmt.Errorf("invalid end time in %q: %w", s, err)
+	}
+	return newParsedRange(s, start, end)
+}
+
+func newParsedRange(s string, start, end time.Time) (TimeRange, error) {
+	if start.After(end) {
+		return TimeRange{}, fmt.Errorf("invalid range %q: start time is after end time", s)
+	}
+	return New(start, end), nil
+}
+
+func isPeriodText(s string) bool {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	return strings.HasPrefix(s, "P")
+}
+
+func parseISOTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	var firstErr error
+	for _, layout := range isoTimeLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return time.Time{}, firstErr
+}
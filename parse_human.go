@@ -0,0 +1,275 @@
+package timerange
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseError represents an error of parsing a time range.
+type ParseError struct {
+	// Input is the whole input.
+	Input string
+	// Token is the offending token, or empty if the input ended unexpectedly.
+	Token string
+	// Offset is the byte offset of the token in the input.
+	Offset int
+	// Reason describes the error.
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("timerange: %s at offset %d in %q", e.Reason, e.Offset, e.Input)
+	}
+	return fmt.Sprintf("timerange: %s: %q at offset %d in %q", e.Reason, e.Token, e.Offset, e.Input)
+}
+
+// ParseHuman parses a human-friendly expression of a time range.
+// Relative expressions are resolved against the current time of the clock in the location.
+//
+// It accepts the following expressions:
+//
+//   - "today", "yesterday" and "tomorrow" are the whole day.
+//   - "this day", "this week", "this month", "this quarter" and "this year" are the whole period.
+//     A week starts on Monday.
+//   - "last N units" ends at the current time, and "next N units" starts at the current time.
+//     The unit is one of second, minute, hour, day, week, month, quarter and year, or plural of them.
+//     If N is omitted, it is 1.
+//   - A date such as "2006-01-02" is the whole day.
+//   - A date and time such as "2006-01-02T15:04:05", "2006-01-02 15:04" or RFC3339 is the instant.
+//     If the time has no offset, it is in the location.
+//
+// Two expressions joined by ".." or "to" represent the range from the start of the former
+// to the end of the latter, for example, "2006-01-02..2006-01-05" or "yesterday to today".
+//
+// If the input is invalid, this returns a *ParseError.
+func ParseHuman(input string, clock Clock, loc *time.Location) (TimeRange, error) {
+	p := humanParser{input: input, now: clock.Now().In(loc), loc: loc}
+	tokens := tokenizeHuman(input)
+	if len(tokens) == 0 {
+		return TimeRange{}, p.errorAtEnd("empty input")
+	}
+	for i, tok := range tokens {
+		if tok.text != ".." && !strings.EqualFold(tok.text, "to") {
+			continue
+		}
+		if i == 0 {
+			return TimeRange{}, p.errorAt(tok, "missing start before separator")
+		}
+		if i == len(tokens)-1 {
+			return TimeRange{}, p.errorAtEnd("missing end after separator")
+		}
+		start, err := p.parse(tokens[:i])
+		if err != nil {
+			return TimeRange{}, err
+		}
+		end, err := p.parse(tokens[i+1:])
+		if err != nil {
+			return TimeRange{}, err
+		}
+		if start.start.After(end.end) {
+			return TimeRange{}, p.errorAt(tokens[i+1], "end is before start")
+		}
+		return New(start.start, end.end), nil
+	}
+	return p.parse(tokens)
+}
+
+type humanToken struct {
+	text   string
+	offset int
+}
+
+// tokenizeHuman splits the input by white spaces and "..".
+func tokenizeHuman(input string) []humanToken {
+	var tokens []humanToken
+	for offset := 0; offset < len(input); {
+		if input[offset] == ' ' || input[offset] == '\t' || input[offset] == '\n' {
+			offset++
+			continue
+		}
+		if strings.HasPrefix(input[offset:], "..") {
+			tokens = append(tokens, humanToken{text: "..", offset: offset})
+			offset += 2
+			continue
+		}
+		end := offset
+		for end < len(input) && !strings.ContainsRune(" \t\n", rune(input[end])) && !strings.HasPrefix(input[end:], "..") {
+			end++
+		}
+		tokens = append(tokens, humanToken{text: input[offset:end], offset: offset})
+		offset = end
+	}
+	return tokens
+}
+
+type humanParser struct {
+	input string
+	now   time.Time
+	loc   *time.Location
+}
+
+func (p humanParser) errorAt(tok humanToken, reason string) error {
+	return &ParseError{Input: p.input, Token: tok.text, Offset: tok.offset, Reason: reason}
+}
+
+func (p humanParser) errorAtEnd(reason string) error {
+	return &ParseError{Input: p.input, Offset: len(p.input), Reason: reason}
+}
+
+// errorAfter returns an error at the end of the token,
+// such as a missing token in an expression before the separator.
+func (p humanParser) errorAfter(tok humanToken, reason string) error {
+	return &ParseError{Input: p.input, Offset: tok.offset + len(tok.text), Reason: reason}
+}
+
+// parse parses an expression which consists of the tokens.
+func (p humanParser) parse(tokens []humanToken) (TimeRange, error) {
+	r, n, err := p.parseExpression(tokens)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	if n < len(tokens) {
+		return TimeRange{}, p.errorAt(tokens[n], "unexpected token")
+	}
+	return r, nil
+}
+
+// parseExpression parses an expression at the head of the tokens.
+// It returns the range and the number of consumed tokens.
+func (p humanParser) parseExpression(tokens []humanToken) (TimeRange, int, error) {
+	head := tokens[0]
+	switch strings.ToLower(head.text) {
+	case "today":
+		return dayOf(p.now), 1, nil
+	case "yesterday":
+		return dayOf(p.now).ShiftDate(0, 0, -1), 1, nil
+	case "tomorrow":
+		return dayOf(p.now).ShiftDate(0, 0, 1), 1, nil
+	case "this":
+		if len(tokens) < 2 {
+			return TimeRange{}, 0, p.errorAfter(head, "missing unit after this")
+		}
+		r, err := p.parseThis(tokens[1])
+		return r, 2, err
+	case "last", "past":
+		return p.parseRelative(tokens, -1)
+	case "next":
+		return p.parseRelative(tokens, 1)
+	}
+	return p.parseDateTime(tokens)
+}
+
+func (p humanParser) parseThis(unit humanToken) (TimeRange, error) {
+	switch strings.ToLower(unit.text) {
+	case "day":
+		return dayOf(p.now), nil
+	case "week":
+		return weekOf(p.now, time.Monday), nil
+	case "month":
+		return monthOf(p.now), nil
+	case "quarter":
+		return quarterOf(p.now), nil
+	case "year":
+		return yearOf(p.now), nil
+	}
+	return TimeRange{}, p.errorAt(unit, "unknown unit")
+}
+
+func (p humanParser) parseRelative(tokens []humanToken, sign int) (TimeRange, int, error) {
+	consumed := 1
+	n := 1
+	if len(tokens) > consumed {
+		if v, err := strconv.Atoi(tokens[consumed].text); err == nil {
+			if v <= 0 {
+				return TimeRange{}, 0, p.errorAt(tokens[consumed], "number must be positive")
+			}
+			n = v
+			consumed++
+		}
+	}
+	if len(tokens) <= consumed {
+		return TimeRange{}, 0, p.errorAfter(tokens[consumed-1], fmt.Sprintf("missing unit after %s", tokens[0].text))
+	}
+	unit := tokens[consumed]
+	consumed++
+	maxN, ok := maxUnits[strings.TrimSuffix(strings.ToLower(unit.text), "s")]
+	if !ok {
+		return TimeRange{}, 0, p.errorAt(unit, "unknown unit")
+	}
+	if int64(n) > maxN {
+		return TimeRange{}, 0, p.errorAt(tokens[1], "number is too large")
+	}
+	t, _ := addUnit(p.now, sign*n, strings.ToLower(unit.text))
+	// time.Time.AddDate wraps around near the limit of time.Time
+	if (sign > 0 && !t.After(p.now)) || (sign < 0 && !t.Before(p.now)) {
+		return TimeRange{}, 0, p.errorAt(tokens[1], "number is too large")
+	}
+	if sign < 0 {
+		return New(t, p.now), consumed, nil
+	}
+	return New(p.now, t), consumed, nil
+}
+
+// maxUnits is the maximum number of each unit.
+// The seconds in the units must not overflow int64.
+var maxUnits = map[string]int64{
+	"second":  math.MaxInt64 / int64(time.Second),
+	"minute":  math.MaxInt64 / int64(time.Minute),
+	"hour":    math.MaxInt64 / int64(time.Hour),
+	"day":     math.MaxInt64 / (24 * 60 * 60),
+	"week":    math.MaxInt64 / (7 * 24 * 60 * 60),
+	"month":   math.MaxInt64 / (31 * 24 * 60 * 60),
+	"quarter": math.MaxInt64 / (92 * 24 * 60 * 60),
+	"year":    math.MaxInt64 / (366 * 24 * 60 * 60),
+}
+
+func addUnit(t time.Time, n int, unit string) (time.Time, bool) {
+	switch strings.TrimSuffix(unit, "s") {
+	case "second":
+		return t.Add(time.Duration(n) * time.Second), true
+	case "minute":
+		return t.Add(time.Duration(n) * time.Minute), true
+	case "hour":
+		return t.Add(time.Duration(n) * time.Hour), true
+	case "day":
+		return t.AddDate(0, 0, n), true
+	case "week":
+		return t.AddDate(0, 0, 7*n), true
+	case "month":
+		return t.AddDate(0, n, 0), true
+	case "quarter":
+		return t.AddDate(0, 3*n, 0), true
+	case "year":
+		return t.AddDate(n, 0, 0), true
+	}
+	return time.Time{}, false
+}
+
+func (p humanParser) parseDateTime(tokens []humanToken) (TimeRange, int, error) {
+	head := tokens[0]
+	if t, err := time.Parse(time.RFC3339, head.text); err == nil {
+		return New(t, t), 1, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, head.text, p.loc); err == nil {
+			return New(t, t), 1, nil
+		}
+	}
+	date, err := time.ParseInLocation(time.DateOnly, head.text, p.loc)
+	if err != nil {
+		return TimeRange{}, 0, p.errorAt(head, "invalid date or expression")
+	}
+	if len(tokens) > 1 {
+		for _, layout := range []string{time.TimeOnly, "15:04"} {
+			if clock, err := time.Parse(layout, tokens[1].text); err == nil {
+				t := time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, p.loc)
+				return New(t, t), 2, nil
+			}
+		}
+	}
+	return dayOf(date), 1, nil
+}
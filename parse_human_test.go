@@ -0,0 +1,94 @@
+package timerange_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestParseHuman(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	// 2006-01-04 is Wednesday
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 4, 15, 4, 5, 0, tokyo))
+
+	for _, c := range []struct {
+		input string
+		want  string
+	}{
+		{"today", "[2006-01-04T00:00:00+09:00, 2006-01-05T00:00:00+09:00]"},
+		{"Yesterday", "[2006-01-03T00:00:00+09:00, 2006-01-04T00:00:00+09:00]"},
+		{"tomorrow", "[2006-01-05T00:00:00+09:00, 2006-01-06T00:00:00+09:00]"},
+		{"this week", "[2006-01-02T00:00:00+09:00, 2006-01-09T00:00:00+09:00]"},
+		{"this month", "[2006-01-01T00:00:00+09:00, 2006-02-01T00:00:00+09:00]"},
+		{"this quarter", "[2006-01-01T00:00:00+09:00, 2006-04-01T00:00:00+09:00]"},
+		{"this year", "[2006-01-01T00:00:00+09:00, 2007-01-01T00:00:00+09:00]"},
+		{"last 7 days", "[2005-12-28T15:04:05+09:00, 2006-01-04T15:04:05+09:00]"},
+		{"past 2 hours", "[2006-01-04T13:04:05+09:00, 2006-01-04T15:04:05+09:00]"},
+		{"last hour", "[2006-01-04T14:04:05+09:00, 2006-01-04T15:04:05+09:00]"},
+		{"next 1 month", "[2006-01-04T15:04:05+09:00, 2006-02-04T15:04:05+09:00]"},
+		{"2006-01-02", "[2006-01-02T00:00:00+09:00, 2006-01-03T00:00:00+09:00]"},
+		{"2006-01-02..2006-01-05", "[2006-01-02T00:00:00+09:00, 2006-01-06T00:00:00+09:00]"},
+		{"2006-01-02 .. 2006-01-05", "[2006-01-02T00:00:00+09:00, 2006-01-06T00:00:00+09:00]"},
+		{"2006-01-02 15:04 to 2006-01-02T16:04:05", "[2006-01-02T15:04:00+09:00, 2006-01-02T16:04:05+09:00]"},
+		{"2006-01-02T06:04:05Z to 2006-01-02T16:04:05+09:00", "[2006-01-02T06:04:05Z, 2006-01-02T16:04:05+09:00]"},
+		{"yesterday to today", "[2006-01-03T00:00:00+09:00, 2006-01-05T00:00:00+09:00]"},
+	} {
+		t.Run(c.input, func(t *testing.T) {
+			r, err := timerange.ParseHuman(c.input, clock, tokyo)
+			if err != nil {
+				t.Fatalf("ParseHuman error: %s", err)
+			}
+			if got := r.String(); c.want != got {
+				t.Errorf("want %v but was %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestParseHuman_Error(t *testing.T) {
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 4, 15, 4, 5, 0, time.UTC))
+
+	for _, c := range []struct {
+		input      string
+		wantToken  string
+		wantOffset int
+	}{
+		{"", "", 0},
+		{"last 2 fortnights", "fortnights", 7},
+		{"last 0 days", "0", 5},
+		{"last 9999999999 hours", "9999999999", 5},
+		{"next 153722868 minutes", "153722868", 5},
+		{"last 9223372036854775807 years", "9223372036854775807", 5},
+		{"next 9223372036854775807 days", "9223372036854775807", 5},
+		{"next 1000000000000000000 weeks", "1000000000000000000", 5},
+		{"next 292277026596 years", "292277026596", 5},
+		{"next 106751991167300 days", "106751991167300", 5},
+		{"this to today", "", 4},
+		{"last .. today", "", 4},
+		{"last 2 to today", "", 6},
+		{"last", "", 4},
+		{"this decade", "decade", 5},
+		{"2006-13-01", "2006-13-01", 0},
+		{"2006-01-02 foo", "foo", 11},
+		{"2006-01-05..2006-01-02", "2006-01-02", 12},
+		{"..2006-01-02", "..", 0},
+		{"2006-01-02 to", "", 13},
+	} {
+		t.Run(c.input, func(t *testing.T) {
+			_, err := timerange.ParseHuman(c.input, clock, time.UTC)
+			var parseError *timerange.ParseError
+			if !errors.As(err, &parseError) {
+				t.Fatalf("want ParseError but was %#v", err)
+			}
+			t.Logf("error: %s", err)
+			if parseError.Token != c.wantToken {
+				t.Errorf("token wants %q but was %q", c.wantToken, parseError.Token)
+			}
+			if parseError.Offset != c.wantOffset {
+				t.Errorf("offset wants %d but was %d", c.wantOffset, parseError.Offset)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestParse(t *testing.T) {
+	want := timerange.New(
+		time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+	)
+	for _, s := range []string{
+		"[2006-01-02T15:00:00Z, 2006-01-02T16:00:00Z]",
+		"[2006-01-03T00:00:00+09:00,2006-01-02T16:00:00.000Z]",
+		want.String(),
+		"2006-01-02T15:00:00Z/2006-01-02T16:00:00Z",
+		"2006-01-02T15:00:00Z/PT1H",
+		"PT1H/2006-01-02T16:00:00Z",
+		"20060102T150000Z/20060102T160000Z",
+		"20060103T000000+0900/PT60M",
+		" 2006-01-02T15:00:00Z / 2006-01-02T16:00:00Z ",
+	} {
+		t.Run(s, func(t *testing.T) {
+			got, err := timerange.Parse(s)
+			if err != nil {
+				t.Fatalf("Parse error: %s", err)
+			}
+			if !want.Equal(got) {
+				t.Errorf("want %v != got %v", want, got)
+			}
+		})
+	}
+	t.Run("calendar duration", func(t *testing.T) {
+		got, err := timerange.Parse("2026-01-31T00:00:00Z/P1M")
+		if err != nil {
+			t.Fatalf("Parse error: %s", err)
+		}
+		want := timerange.New(
+			time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+}
+
+func TestParse_Error(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"2006-01-02T15:00:00Z",
+		"[2006-01-02T15:00:00Z, 2006-01-02T16:00:00Z",
+		"[2006-01-02T15:00:00Z 2006-01-02T16:00:00Z]",
+		"[2006-01-02, 2006-01-03]",
+		"2006-01-02T16:00:00Z/2006-01-02T15:00:00Z",
+		"2006-01-02T15:00:00Z/-PT1H",
+		"PT1H/PT2H",
+		"2006-01-02T15:00:00Z/PT1X",
+		"today/PT1H",
+	} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := timerange.Parse(s); err == nil {
+				t.Errorf("want error but was nil")
+			}
+		})
+	}
+}
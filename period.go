@@ -0,0 +1,220 @@
+package timerange
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period represents a calendar-aware amount of time, such as 1 month and 2 hours.
+// The calendar components are added by time.Time.AddDate,
+// and then the clock component is added by time.Time.Add.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+	// Duration is the clock component, such as hours, minutes and seconds.
+	Duration time.Duration
+}
+
+// ParsePeriod parses an ISO 8601 duration, such as "P1Y2M3DT4H5M6.5S" or "P2W".
+// A week is 7 days.
+// A leading minus sign negates all components, such as "-P1D".
+// Each component may have a sign as well, such as "P1M-2D".
+// Only the hours, minutes and seconds may have a fraction.
+func ParsePeriod(s string) (Period, error) {
+	rest := s
+	negative := false
+	switch {
+	case strings.HasPrefix(rest, "-"):
+		negative = true
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "+"):
+		rest = rest[1:]
+	}
+	rest, ok := strings.CutPrefix(rest, "P")
+	if !ok {
+		return Period{}, fmt.Errorf("invalid period %q: want P", s)
+	}
+	dateText, timeText, hasTime := strings.Cut(rest, "T")
+	if dateText == "" && timeText == "" {
+		return Period{}, fmt.Errorf("invalid period %q: no component", s)
+	}
+	if hasTime && timeText == "" {
+		return Period{}, fmt.Errorf("invalid period %q: no component after T", s)
+	}
+
+	var p Period
+	if err := parsePeriodComponents(dateText, "YMWD", func(designator byte, number string) error {
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return err
+		}
+		switch designator {
+		case 'Y':
+			p.Years = n
+		case 'M':
+			p.Months = n
+		case 'W':
+			p.Days += 7 * n
+		case 'D':
+			p.Days += n
+		}
+		return nil
+	}); err != nil {
+		return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+	}
+	if err := parsePeriodComponents(timeText, "HMS", func(designator byte, number string) error {
+		d, err := time.ParseDuration(number + strings.ToLower(string(designator)))
+		if err != nil {
+			return err
+		}
+		p.Duration += d
+		return nil
+	}); err != nil {
+		return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+	}
+	if negative {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// parsePeriodComponents parses the components such as "1Y2M" in the order of designators.
+func parsePeriodComponents(s, designators string, f func(designator byte, number string) error) error {
+	for s != "" {
+		end := strings.IndexFunc(s, func(r rune) bool {
+			return r >= 'A' && r <= 'Z'
+		})
+		if end < 0 {
+			return fmt.Errorf("missing designator after %q", s)
+		}
+		i := strings.IndexByte(designators, s[end])
+		if i < 0 {
+			return fmt.Errorf("unexpected designator %c", s[end])
+		}
+		number := s[:end]
+		if number == "" || number == "-" || number == "+" {
+			return fmt.Errorf("missing number before %c", s[end])
+		}
+		if err := f(s[end], number); err != nil {
+			return err
+		}
+		designators, s = designators[i+1:], s[end+1:]
+	}
+	return nil
+}
+
+// String returns the ISO 8601 representation, such as "P1M2DT3H".
+// If all components are zero or negative, it has a leading minus sign, such as "-P1D".
+// A zero value is "PT0S".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "PT0S"
+	}
+	var b strings.Builder
+	if p.Years <= 0 && p.Months <= 0 && p.Days <= 0 && p.Duration <= 0 {
+		b.WriteString("-")
+		p = p.Negate()
+	}
+	b.WriteString("P")
+	for _, c := range []struct {
+		n          int
+		designator string
+	}{{p.Years, "Y"}, {p.Months, "M"}, {p.Days, "D"}} {
+		if c.n != 0 {
+			b.WriteString(strconv.Itoa(c.n) + c.designator)
+		}
+	}
+	if p.Duration == 0 {
+		return b.String()
+	}
+	b.WriteString("T")
+	d := p.Duration
+	if h := d / time.Hour; h != 0 {
+		b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+		d -= h * time.Hour
+	}
+	if m := d / time.Minute; m != 0 {
+		b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+		d -= m * time.Minute
+	}
+	if d != 0 {
+		if d < 0 {
+			b.WriteString("-")
+			d = -d
+		}
+		b.WriteString(strconv.FormatInt(int64(d/time.Second), 10))
+		if frac := d % time.Second; frac != 0 {
+			b.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", frac), "0"))
+		}
+		b.WriteString("S")
+	}
+	return b.String()
+}
+
+// IsZero returns true if all components are zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns the period whose components are negated.
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days, Duration: -p.Duration}
+}
+
+// Normalize returns the period whose months are carried into years,
+// such as "P1Y14M" to "P2Y2M".
+// Days and the clock component are kept as they are,
+// because the length of a day varies with daylight saving time.
+func (p Period) Normalize() Period {
+	months := p.Years*12 + p.Months
+	return Period{Years: months / 12, Months: months % 12, Days: p.Days, Duration: p.Duration}
+}
+
+// AddTo returns the time after the period.
+func (p Period) AddTo(t time.Time) time.Time {
+	return t.AddDate(p.Years, p.Months, p.Days).Add(p.Duration)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Period) UnmarshalText(text []byte) error {
+	parsed, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// FromPeriod returns a TimeRange with start time and period.
+// The period must be positive.
+func FromPeriod(start time.Time, p Period) TimeRange {
+	return New(start, p.AddTo(start))
+}
+
+// UntilPeriod returns a TimeRange with end time and period.
+// The period must be positive.
+func UntilPeriod(end time.Time, p Period) TimeRange {
+	return New(p.Negate().AddTo(end), end)
+}
+
+// ShiftPeriod returns a TimeRange moved by the period.
+// If the period is positive, this returns the later range.
+// If the period is negative, this returns the earlier range.
+func (r TimeRange) ShiftPeriod(p Period) TimeRange {
+	return New(p.AddTo(r.start), p.AddTo(r.end))
+}
+
+// ExtendPeriod returns an extended TimeRange for the period.
+// If the period is positive, this returns the longer range.
+// If the period is negative, this returns the shorter range.
+func (r TimeRange) ExtendPeriod(p Period) TimeRange {
+	return New(r.start, p.AddTo(r.end))
+}
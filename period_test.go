@@ -0,0 +1,180 @@
+package timerange_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestParsePeriod(t *testing.T) {
+	for _, c := range []struct {
+		s          string
+		want       timerange.Period
+		wantString string
+	}{
+		{"P1M2DT3H", timerange.Period{Months: 1, Days: 2, Duration: 3 * time.Hour}, "P1M2DT3H"},
+		{"P1Y2M3DT4H5M6S", timerange.Period{Years: 1, Months: 2, Days: 3, Duration: 4*time.Hour + 5*time.Minute + 6*time.Second}, "P1Y2M3DT4H5M6S"},
+		{"P2W", timerange.Period{Days: 14}, "P14D"},
+		{"P1W2D", timerange.Period{Days: 9}, "P9D"},
+		{"PT1M", timerange.Period{Duration: time.Minute}, "PT1M"},
+		{"PT1.5H", timerange.Period{Duration: 90 * time.Minute}, "PT1H30M"},
+		{"PT0.000000001S", timerange.Period{Duration: time.Nanosecond}, "PT0.000000001S"},
+		{"PT90S", timerange.Period{Duration: 90 * time.Second}, "PT1M30S"},
+		{"PT0S", timerange.Period{}, "PT0S"},
+		{"P0D", timerange.Period{}, "PT0S"},
+		{"-P1DT2H", timerange.Period{Days: -1, Duration: -2 * time.Hour}, "-P1DT2H"},
+		{"+P1D", timerange.Period{Days: 1}, "P1D"},
+		{"P1M-2D", timerange.Period{Months: 1, Days: -2}, "P1M-2D"},
+		{"PT1H-30.5S", timerange.Period{Duration: time.Hour - 30500*time.Millisecond}, "PT59M29.5S"},
+		{"-P1M-2D", timerange.Period{Months: -1, Days: 2}, "P-1M2D"},
+	} {
+		t.Run(c.s, func(t *testing.T) {
+			got, err := timerange.ParsePeriod(c.s)
+			if err != nil {
+				t.Fatalf("ParsePeriod error: %s", err)
+			}
+			if c.want != got {
+				t.Errorf("want %+v != got %+v", c.want, got)
+			}
+			if c.wantString != got.String() {
+				t.Errorf("String wants %s but was %s", c.wantString, got.String())
+			}
+			reparsed, err := timerange.ParsePeriod(got.String())
+			if err != nil {
+				t.Fatalf("ParsePeriod error: %s", err)
+			}
+			if got != reparsed {
+				t.Errorf("round trip wants %+v != got %+v", got, reparsed)
+			}
+		})
+	}
+	for _, s := range []string{"", "P", "1D", "PT", "P1DT", "P1", "P1.5D", "PD", "P-D", "P1D1M", "P1H", "PT1D", "PT1Y", "p1d", "P1D2D"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := timerange.ParsePeriod(s); err == nil {
+				t.Errorf("want error but was nil")
+			}
+		})
+	}
+}
+
+func TestPeriod_Normalize(t *testing.T) {
+	for _, c := range []struct {
+		p    timerange.Period
+		want timerange.Period
+	}{
+		{timerange.Period{Years: 1, Months: 14, Days: 40}, timerange.Period{Years: 2, Months: 2, Days: 40}},
+		{timerange.Period{Months: -13}, timerange.Period{Years: -1, Months: -1}},
+		{timerange.Period{Years: 1, Months: -1}, timerange.Period{Months: 11}},
+		{timerange.Period{Duration: 48 * time.Hour}, timerange.Period{Duration: 48 * time.Hour}},
+	} {
+		if got := c.p.Normalize(); c.want != got {
+			t.Errorf("%v: want %v != got %v", c.p, c.want, got)
+		}
+	}
+}
+
+func TestPeriod_Negate(t *testing.T) {
+	p := timerange.Period{Years: 1, Months: -2, Days: 3, Duration: time.Hour}
+	want := timerange.Period{Years: -1, Months: 2, Days: -3, Duration: -time.Hour}
+	if got := p.Negate(); want != got {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
+
+func TestPeriod_MarshalText(t *testing.T) {
+	type subscription struct {
+		Interval timerange.Period `json:"interval"`
+	}
+	b, err := json.Marshal(subscription{Interval: timerange.Period{Months: 1}})
+	if err != nil {
+		t.Fatalf("json.Marshal error: %s", err)
+	}
+	if want, got := `{"interval":"P1M"}`, string(b); want != got {
+		t.Errorf("want %s but was %s", want, got)
+	}
+	var decoded subscription
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal error: %s", err)
+	}
+	if want := (timerange.Period{Months: 1}); want != decoded.Interval {
+		t.Errorf("want %v != got %v", want, decoded.Interval)
+	}
+}
+
+func TestFromPeriod(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %s", err)
+	}
+	start := time.Date(2026, 10, 31, 12, 0, 0, 0, newYork)
+	got := timerange.FromPeriod(start, timerange.Period{Days: 1, Duration: 2 * time.Hour})
+	// The day across the end of daylight saving time has 25 hours
+	want := timerange.New(start, time.Date(2026, 11, 1, 14, 0, 0, 0, newYork))
+	if !want.Equal(got) {
+		t.Errorf("want %v != got %v", want, got)
+	}
+	if want, got := 27*time.Hour, got.Duration(); want != got {
+		t.Errorf("Duration wants %v but was %v", want, got)
+	}
+}
+
+func TestUntilPeriod(t *testing.T) {
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	got := timerange.UntilPeriod(end, timerange.Period{Months: 1, Duration: time.Hour})
+	want := timerange.New(time.Date(2026, 3, 2, 23, 0, 0, 0, time.UTC), end)
+	if !want.Equal(got) {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
+
+func TestTimeRange_ShiftPeriod(t *testing.T) {
+	r := timerange.New(
+		time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 31, 18, 0, 0, 0, time.UTC),
+	)
+	t.Run("positive", func(t *testing.T) {
+		got := r.ShiftPeriod(timerange.Period{Months: 1, Duration: time.Hour})
+		want := timerange.New(
+			time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 3, 19, 0, 0, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("negative", func(t *testing.T) {
+		got := r.ShiftPeriod(timerange.Period{Days: -1, Duration: -time.Hour})
+		want := timerange.New(
+			time.Date(2026, 1, 30, 8, 0, 0, 0, time.UTC),
+			time.Date(2026, 1, 30, 17, 0, 0, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+}
+
+func TestTimeRange_ExtendPeriod(t *testing.T) {
+	r := timerange.New(
+		time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 31, 18, 0, 0, 0, time.UTC),
+	)
+	t.Run("positive", func(t *testing.T) {
+		got := r.ExtendPeriod(timerange.Period{Days: 1, Duration: 30 * time.Minute})
+		want := timerange.New(
+			time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 1, 18, 30, 0, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("negative beyond start", func(t *testing.T) {
+		got := r.ExtendPeriod(timerange.Period{Days: -1})
+		if !got.IsZero() {
+			t.Errorf("want zero but was %v", got)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package timerange
+
+import "time"
+
+// Last returns a TimeRange which ends at the current time of the clock.
+// The duration must be positive.
+func Last(clock Clock, duration time.Duration) TimeRange {
+	return Until(clock.Now(), duration)
+}
+
+// Next returns a TimeRange which starts at the current time of the clock.
+// The duration must be positive.
+func Next(clock Clock, duration time.Duration) TimeRange {
+	return From(clock.Now(), duration)
+}
+
+// Today returns a TimeRange of the current day in the location.
+// It starts at the midnight of the day and ends at the midnight of the next day.
+func Today(clock Clock, loc *time.Location) TimeRange {
+	return dayOf(clock.Now().In(loc))
+}
+
+// ThisWeek returns a TimeRange of the current week in the location.
+// The week starts at the midnight of weekStart, such as time.Sunday or time.Monday,
+// and ends at the midnight of weekStart in the next week.
+func ThisWeek(clock Clock, loc *time.Location, weekStart time.Weekday) TimeRange {
+	return weekOf(clock.Now().In(loc), weekStart)
+}
+
+// ThisMonth returns a TimeRange of the current month in the location.
+// It starts at the midnight of the first day of the month
+// and ends at the midnight of the first day of the next month.
+func ThisMonth(clock Clock, loc *time.Location) TimeRange {
+	return monthOf(clock.Now().In(loc))
+}
+
+func dayOf(t time.Time) TimeRange {
+	start := startOfDay(t)
+	return New(start, start.AddDate(0, 0, 1))
+}
+
+func weekOf(t time.Time, weekStart time.Weekday) TimeRange {
+	today := startOfDay(t)
+	offset := (int(today.Weekday()) - int(weekStart) + 7) % 7
+	start := today.AddDate(0, 0, -offset)
+	return New(start, start.AddDate(0, 0, 7))
+}
+
+func monthOf(t time.Time) TimeRange {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return New(start, start.AddDate(0, 1, 0))
+}
+
+func quarterOf(t time.Time) TimeRange {
+	start := time.Date(t.Year(), t.Month()-(t.Month()-1)%3, 1, 0, 0, 0, 0, t.Location())
+	return New(start, start.AddDate(0, 3, 0))
+}
+
+func yearOf(t time.Time) TimeRange {
+	start := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	return New(start, start.AddDate(1, 0, 0))
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
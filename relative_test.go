@@ -0,0 +1,77 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestLast(t *testing.T) {
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+	got := timerange.Last(clock, 24*time.Hour)
+	want := timerange.New(
+		time.Date(2006, 1, 1, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+	)
+	if !want.Equal(got) {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
+
+func TestNext(t *testing.T) {
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+	got := timerange.Next(clock, time.Hour)
+	want := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 16, 4, 5, 0, time.UTC),
+	)
+	if !want.Equal(got) {
+		t.Errorf("want %v != got %v", want, got)
+	}
+}
+
+func TestToday(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+	got := timerange.Today(clock, tokyo).String()
+	want := "[2006-01-03T00:00:00+09:00, 2006-01-04T00:00:00+09:00]"
+	if want != got {
+		t.Errorf("want %v but was %v", want, got)
+	}
+}
+
+func TestThisWeek(t *testing.T) {
+	// 2006-01-04 is Wednesday
+	clock := timerange.NewFakeClock(time.Date(2006, 1, 4, 15, 4, 5, 0, time.UTC))
+	t.Run("Sunday", func(t *testing.T) {
+		got := timerange.ThisWeek(clock, time.UTC, time.Sunday).String()
+		want := "[2006-01-01T00:00:00Z, 2006-01-08T00:00:00Z]"
+		if want != got {
+			t.Errorf("want %v but was %v", want, got)
+		}
+	})
+	t.Run("Monday", func(t *testing.T) {
+		got := timerange.ThisWeek(clock, time.UTC, time.Monday).String()
+		want := "[2006-01-02T00:00:00Z, 2006-01-09T00:00:00Z]"
+		if want != got {
+			t.Errorf("want %v but was %v", want, got)
+		}
+	})
+	t.Run("Thursday", func(t *testing.T) {
+		got := timerange.ThisWeek(clock, time.UTC, time.Thursday).String()
+		want := "[2005-12-29T00:00:00Z, 2006-01-05T00:00:00Z]"
+		if want != got {
+			t.Errorf("want %v but was %v", want, got)
+		}
+	})
+}
+
+func TestThisMonth(t *testing.T) {
+	clock := timerange.NewFakeClock(time.Date(2006, 12, 31, 15, 4, 5, 0, time.UTC))
+	got := timerange.ThisMonth(clock, time.UTC).String()
+	want := "[2006-12-01T00:00:00Z, 2007-01-01T00:00:00Z]"
+	if want != got {
+		t.Errorf("want %v but was %v", want, got)
+	}
+}
@@ -0,0 +1,79 @@
+package timerange
+
+import (
+	"math"
+	"time"
+)
+
+// ExtendStart returns a TimeRange extended before the start time for the duration.
+// If the duration is positive, this returns the longer range.
+// If the duration is negative, this returns the shorter range.
+// If the start time passes the end time, this returns a zero value as well as Extend.
+func (r TimeRange) ExtendStart(d time.Duration) TimeRange {
+	return New(r.start.Add(-d), r.end)
+}
+
+// Pad returns a TimeRange extended before the start time and after the end time.
+// If the start time passes the end time by negative padding, this returns a zero value as well as Extend.
+func (r TimeRange) Pad(before, after time.Duration) TimeRange {
+	return New(r.start.Add(-before), r.end.Add(after))
+}
+
+// Shrink returns a TimeRange shortened from both the start time and end time for the duration.
+// If the duration is negative, this returns the longer range.
+// If the range is shorter than twice the duration,
+// this returns the zero-length range at the midpoint.
+// If this is a zero value, it returns a zero value.
+func (r TimeRange) Shrink(d time.Duration) TimeRange {
+	if r.IsZero() {
+		return TimeRange{}
+	}
+	start, end := r.start.Add(d), r.end.Add(-d)
+	if start.After(end) {
+		mid := r.Midpoint()
+		return TimeRange{start: mid, end: mid}
+	}
+	return TimeRange{start: start, end: end}
+}
+
+// Scale returns a TimeRange scaled by the factor around the midpoint.
+// For example, 2 returns the range of twice the duration, and 0.5 returns the half.
+// If the factor is 0 or negative, this returns the zero-length range at the midpoint.
+// If this is a zero value, it returns a zero value.
+func (r TimeRange) Scale(factor float64) TimeRange {
+	// Shrinking from both ends keeps the range as it is if the factor is 1.
+	return r.Shrink(floatToDuration(float64(r.Duration()) * (1 - factor) / 2))
+}
+
+// Midpoint returns the time at the middle of the start time and end time.
+// It is in the location of the start time.
+func (r TimeRange) Midpoint() time.Time {
+	// Compute in seconds to avoid overflow of time.Duration for a range longer than 292 years.
+	sec := r.end.Unix() - r.start.Unix()
+	nsec := int64(r.end.Nanosecond()-r.start.Nanosecond()) + sec%2*int64(time.Second)
+	return time.Unix(r.start.Unix()+sec/2, int64(r.start.Nanosecond())+nsec/2).In(r.start.Location())
+}
+
+// Clamp returns the nearest time within this range.
+// If the time is before the start time, this returns the start time.
+// If the time is after the end time, this returns the end time.
+// Otherwise, this returns the time as it is.
+func (r TimeRange) Clamp(t time.Time) time.Time {
+	switch {
+	case t.Before(r.start):
+		return r.start
+	case t.After(r.end):
+		return r.end
+	}
+	return t
+}
+
+func floatToDuration(f float64) time.Duration {
+	switch {
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	}
+	return time.Duration(f)
+}
@@ -0,0 +1,208 @@
+package timerange_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+func TestTimeRange_ExtendStart(t *testing.T) {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	t.Run("longer", func(t *testing.T) {
+		got := r.ExtendStart(15 * time.Second)
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 50, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("shorter", func(t *testing.T) {
+		got := r.ExtendStart(-15 * time.Second)
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 20, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("past the end", func(t *testing.T) {
+		if got := r.ExtendStart(-3 * time.Minute); !got.IsZero() {
+			t.Errorf("want zero but was %v", got)
+		}
+	})
+}
+
+func TestTimeRange_Pad(t *testing.T) {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	t.Run("both", func(t *testing.T) {
+		got := r.Pad(5*time.Minute, 10*time.Minute)
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 0, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 17, 5, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("negative", func(t *testing.T) {
+		got := r.Pad(-time.Minute, -time.Minute)
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("crossing", func(t *testing.T) {
+		if got := r.Pad(-time.Minute, -2*time.Minute); !got.IsZero() {
+			t.Errorf("want zero but was %v", got)
+		}
+	})
+}
+
+func TestTimeRange_Shrink(t *testing.T) {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	for _, c := range []struct {
+		name string
+		d    time.Duration
+		want timerange.TimeRange
+	}{
+		{"shorter", 30 * time.Second, timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 35, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 35, 0, time.UTC),
+		)},
+		{"longer", -30 * time.Second, timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 35, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 35, 0, time.UTC),
+		)},
+		{"exactly zero length", time.Minute, timerange.New(
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+		)},
+		{"past zero length", time.Hour, timerange.New(
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+		)},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := r.Shrink(c.d); !c.want.Equal(got) {
+				t.Errorf("want %v != got %v", c.want, got)
+			}
+		})
+	}
+	t.Run("zero", func(t *testing.T) {
+		if got := (timerange.TimeRange{}).Shrink(time.Hour); !got.IsZero() {
+			t.Errorf("want zero but was %v", got)
+		}
+	})
+}
+
+func TestTimeRange_Scale(t *testing.T) {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	for _, c := range []struct {
+		name   string
+		factor float64
+		want   timerange.TimeRange
+	}{
+		{"identity", 1, r},
+		{"double", 2, timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 8, 5, 0, time.UTC),
+		)},
+		{"half", 0.5, timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 35, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 35, 0, time.UTC),
+		)},
+		{"zero", 0, timerange.New(
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+		)},
+		{"negative", -1, timerange.New(
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 6, 5, 0, time.UTC),
+		)},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := r.Scale(c.factor); !c.want.Equal(got) {
+				t.Errorf("want %v != got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeRange_Midpoint(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	for _, c := range []struct {
+		name string
+		r    timerange.TimeRange
+		want time.Time
+	}{
+		{"even", timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 5, 0, jst),
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+		), time.Date(2006, 1, 2, 19, 36, 5, 0, jst)},
+		{"odd nanoseconds", timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 5, 6, 1, time.UTC),
+		), time.Date(2006, 1, 2, 15, 5, 5, 500000000, time.UTC)},
+		{"longer than time.Duration", timerange.New(
+			time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC),
+		), time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"zero length", timerange.New(
+			time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+		), time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC)},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			got := c.r.Midpoint()
+			if !c.want.Equal(got) {
+				t.Errorf("want %v != got %v", c.want, got)
+			}
+			if c.r.Start().Location() != got.Location() {
+				t.Errorf("location wants %v but was %v", c.r.Start().Location(), got.Location())
+			}
+		})
+	}
+}
+
+func TestTimeRange_Clamp(t *testing.T) {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 5, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	for _, c := range []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{"before", time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC), r.Start()},
+		{"start", r.Start(), r.Start()},
+		{"within", time.Date(2006, 1, 2, 15, 6, 0, 0, time.UTC), time.Date(2006, 1, 2, 15, 6, 0, 0, time.UTC)},
+		{"end", r.End(), r.End()},
+		{"after", time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC), r.End()},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := r.Clamp(c.t); !c.want.Equal(got) {
+				t.Errorf("want %v != got %v", c.want, got)
+			}
+		})
+	}
+}
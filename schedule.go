@@ -0,0 +1,389 @@
+package timerange
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"time"
+)
+
+// scheduleLookaheadDays is the number of days to search for the next opening or closing.
+const scheduleLookaheadDays = 2 * 366
+
+var scheduleWeekdays = map[string]time.Weekday{
+	"su": time.Sunday,
+	"mo": time.Monday,
+	"tu": time.Tuesday,
+	"we": time.Wednesday,
+	"th": time.Thursday,
+	"fr": time.Friday,
+	"sa": time.Saturday,
+}
+
+var wholeDay = TimeOfDayRange{end: TimeOfDay{Hour: 24}}
+
+// WeeklySchedule represents weekly opening hours in a location, with overrides for specific dates.
+// A window which wraps past the midnight belongs to the day it starts.
+//
+// A WeeklySchedule is safe for concurrent reads, but not for concurrent use with SetWeekday or Override.
+type WeeklySchedule struct {
+	loc       *time.Location
+	weekdays  [7][]TimeOfDayRange
+	overrides map[Date][]TimeOfDayRange
+}
+
+// NewWeeklySchedule returns a WeeklySchedule in the location which is always closed.
+func NewWeeklySchedule(loc *time.Location) *WeeklySchedule {
+	return &WeeklySchedule{loc: loc, overrides: make(map[Date][]TimeOfDayRange)}
+}
+
+// ParseWeeklySchedule parses a subset of the OpenStreetMap opening_hours syntax,
+// such as "Mo-Fr 09:00-18:00; Sa 10:00-14:00; 2026 Dec 25 off".
+//
+// The input consists of rules separated by ";".
+// Each rule has a selector followed by time ranges separated by ",", "off" or "closed".
+// The selector is one of:
+//
+//   - Weekdays such as "Mo", "Mo-Fr" or "Mo,We,Fr-Su".
+//   - A date such as "2026 Dec 25", which overrides the weekly hours.
+//   - Omitted, which means every day.
+//
+// If the time ranges are omitted, the rule means the whole day.
+// "24/7" means always open.
+// A later rule replaces the hours of the days selected by an earlier rule.
+//
+// If the input is invalid, this returns a *ParseError.
+func ParseWeeklySchedule(input string, loc *time.Location) (*WeeklySchedule, error) {
+	s := NewWeeklySchedule(loc)
+	p := scheduleParser{input: input, tokens: tokenizeSchedule(input)}
+	for {
+		if err := p.parseRule(s); err != nil {
+			return nil, err
+		}
+		if p.done() {
+			return s, nil
+		}
+		if tok := p.next(); tok.text != ";" {
+			return nil, p.errorAt(tok, "want ;")
+		}
+	}
+}
+
+// Location returns the location of this schedule.
+func (s *WeeklySchedule) Location() *time.Location {
+	return s.loc
+}
+
+// SetWeekday replaces the hours of the weekday.
+// If no window is given, it is closed on the weekday.
+func (s *WeeklySchedule) SetWeekday(weekday time.Weekday, windows ...TimeOfDayRange) {
+	s.weekdays[weekday] = sortedWindows(windows)
+}
+
+// Override replaces the hours of the date, such as a holiday.
+// If no window is given, it is closed on the date.
+func (s *WeeklySchedule) Override(d Date, windows ...TimeOfDayRange) {
+	s.overrides[d] = sortedWindows(windows)
+}
+
+func sortedWindows(windows []TimeOfDayRange) []TimeOfDayRange {
+	windows = slices.Clone(windows)
+	slices.SortFunc(windows, func(a, b TimeOfDayRange) int {
+		return a.start.Compare(b.start)
+	})
+	return windows
+}
+
+// WindowsOn returns the windows of the date.
+func (s *WeeklySchedule) WindowsOn(d Date) []TimeOfDayRange {
+	if windows, ok := s.overrides[d]; ok {
+		return windows
+	}
+	return s.weekdays[d.Weekday()]
+}
+
+// IsOpen returns true if it is open at the time.
+func (s *WeeklySchedule) IsOpen(t time.Time) bool {
+	today := DateOf(t.In(s.loc))
+	for _, d := range []Date{today.AddDays(-1), today} {
+		for _, w := range s.WindowsOn(d) {
+			if w.On(d, s.loc).Contains(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NextOpen returns the earliest time at or after t when it is open.
+// If it is open at t, this returns t.
+// If it does not open within about 2 years, this returns false.
+func (s *WeeklySchedule) NextOpen(t time.Time) (time.Time, bool) {
+	for p := range s.periods(t) {
+		if p.Contains(t) {
+			return t, true
+		}
+		return p.start, true
+	}
+	return time.Time{}, false
+}
+
+// NextClose returns the end of the open period which contains t or starts after t.
+// If it does not close within about 2 years, this returns false.
+func (s *WeeklySchedule) NextClose(t time.Time) (time.Time, bool) {
+	for p, complete := range s.periods(t) {
+		return p.end, complete
+	}
+	return time.Time{}, false
+}
+
+// periods returns an iterator over the merged open periods which end at or after t.
+// If a period may continue beyond the lookahead, it is yielded with false and the iteration stops.
+func (s *WeeklySchedule) periods(t time.Time) iter.Seq2[TimeRange, bool] {
+	first := DateOf(t.In(s.loc)).AddDays(-1)
+	last := first.AddDays(scheduleLookaheadDays)
+	return func(yield func(TimeRange, bool) bool) {
+		for p := range MergeSorted(s.windows(first, last), 0) {
+			if p.end.Before(t) {
+				continue
+			}
+			if !DateOf(p.end.In(s.loc)).Before(last) {
+				yield(p, false)
+				return
+			}
+			if !yield(p, true) {
+				return
+			}
+		}
+	}
+}
+
+// windows returns an iterator over the windows from the first date to the last date,
+// sorted by start time.
+func (s *WeeklySchedule) windows(first, last Date) iter.Seq[TimeRange] {
+	return func(yield func(TimeRange) bool) {
+		for d := first; !d.After(last); d = d.AddDays(1) {
+			for _, w := range s.WindowsOn(d) {
+				if !yield(w.On(d, s.loc)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Ranges returns the open periods within the TimeRange.
+// Windows which overlap or touch each other are merged,
+// and each period is clipped to the TimeRange.
+func (s *WeeklySchedule) Ranges(over TimeRange) []TimeRange {
+	if over.IsZero() {
+		return nil
+	}
+	// A window of the previous day may wrap into the first day.
+	first := DateOf(over.start.In(s.loc)).AddDays(-1)
+	last := DateOf(over.end.In(s.loc))
+	var ranges []TimeRange
+	for p := range MergeSorted(s.windows(first, last), 0) {
+		clipped := Intersect(p, over)
+		if clipped.IsZero() {
+			continue
+		}
+		// Skip a period which only touches the boundary of the TimeRange.
+		if clipped.Duration() == 0 && p.Duration() > 0 {
+			continue
+		}
+		ranges = append(ranges, clipped)
+	}
+	return ranges
+}
+
+type scheduleParser struct {
+	input  string
+	tokens []humanToken
+	pos    int
+}
+
+func (p *scheduleParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *scheduleParser) peek() humanToken {
+	if p.done() {
+		return humanToken{offset: len(p.input)}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *scheduleParser) next() humanToken {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *scheduleParser) errorAt(tok humanToken, reason string) error {
+	return &ParseError{Input: p.input, Token: tok.text, Offset: tok.offset, Reason: reason}
+}
+
+func (p *scheduleParser) parseRule(s *WeeklySchedule) error {
+	if tok := p.peek(); tok.text == "24/7" {
+		p.next()
+		for weekday := range s.weekdays {
+			s.weekdays[weekday] = []TimeOfDayRange{wholeDay}
+		}
+		return nil
+	}
+	if tok := p.peek(); tok.text == "" || tok.text == ";" {
+		return p.errorAt(tok, "empty rule")
+	}
+
+	var weekdays []time.Weekday
+	var date Date
+	switch tok := p.peek(); {
+	case len(tok.text) == 4 && isDigits(tok.text):
+		d, err := p.parseDate()
+		if err != nil {
+			return err
+		}
+		date = d
+	case !isDigit(tok.text[0]) && !isScheduleOff(tok.text):
+		w, err := p.parseWeekdays()
+		if err != nil {
+			return err
+		}
+		weekdays = w
+	default:
+		for weekday := range 7 {
+			weekdays = append(weekdays, time.Weekday(weekday))
+		}
+	}
+
+	windows, err := p.parseWindows()
+	if err != nil {
+		return err
+	}
+	if !date.IsZero() {
+		s.Override(date, windows...)
+		return nil
+	}
+	for _, weekday := range weekdays {
+		s.SetWeekday(weekday, windows...)
+	}
+	return nil
+}
+
+func (p *scheduleParser) parseDate() (Date, error) {
+	first := p.peek()
+	var parts []string
+	for range 3 {
+		tok := p.next()
+		if tok.text == "" || tok.text == ";" || tok.text == "," {
+			return Date{}, p.errorAt(tok, "want a date such as 2026 Dec 25")
+		}
+		parts = append(parts, tok.text)
+	}
+	t, err := time.Parse("2006 Jan 2", strings.Join(parts, " "))
+	if err != nil {
+		return Date{}, p.errorAt(first, "invalid date")
+	}
+	return DateOf(t), nil
+}
+
+func (p *scheduleParser) parseWeekdays() ([]time.Weekday, error) {
+	var weekdays []time.Weekday
+	for {
+		tok := p.next()
+		from, to, isRange := cutDash(tok.text)
+		start, ok := scheduleWeekdays[strings.ToLower(from)]
+		if !ok {
+			return nil, p.errorAt(tok, "want a weekday such as Mo or Mo-Fr")
+		}
+		end := start
+		if isRange {
+			end, ok = scheduleWeekdays[strings.ToLower(to)]
+			if !ok {
+				return nil, p.errorAt(tok, "want a weekday such as Mo or Mo-Fr")
+			}
+		}
+		// A range may wrap around the week, such as Fr-Mo.
+		for weekday := start; ; weekday = (weekday + 1) % 7 {
+			weekdays = append(weekdays, weekday)
+			if weekday == end {
+				break
+			}
+		}
+		if p.peek().text != "," {
+			return weekdays, nil
+		}
+		p.next()
+	}
+}
+
+func (p *scheduleParser) parseWindows() ([]TimeOfDayRange, error) {
+	if tok := p.peek(); tok.text == "" || tok.text == ";" {
+		return []TimeOfDayRange{wholeDay}, nil
+	}
+	if tok := p.peek(); isScheduleOff(tok.text) {
+		p.next()
+		return nil, nil
+	}
+	var windows []TimeOfDayRange
+	for {
+		tok := p.next()
+		w, err := ParseTimeOfDayRange(tok.text)
+		if err != nil {
+			return nil, p.errorAt(tok, "want a time range such as 09:00-18:00")
+		}
+		windows = append(windows, w)
+		if p.peek().text != "," {
+			return windows, nil
+		}
+		p.next()
+	}
+}
+
+// tokenizeSchedule splits the input by white spaces, and "," and ";" as tokens.
+func tokenizeSchedule(input string) []humanToken {
+	var tokens []humanToken
+	for offset := 0; offset < len(input); {
+		switch input[offset] {
+		case ' ', '\t', '\n':
+			offset++
+			continue
+		case ',', ';':
+			tokens = append(tokens, humanToken{text: input[offset : offset+1], offset: offset})
+			offset++
+			continue
+		}
+		end := offset
+		for end < len(input) && !strings.ContainsRune(" \t\n,;", rune(input[end])) {
+			end++
+		}
+		tokens = append(tokens, humanToken{text: input[offset:end], offset: offset})
+		offset = end
+	}
+	return tokens
+}
+
+func cutDash(s string) (before, after string, found bool) {
+	if before, after, found = strings.Cut(s, "-"); found {
+		return
+	}
+	return strings.Cut(s, "–")
+}
+
+func isScheduleOff(s string) bool {
+	return strings.EqualFold(s, "off") || strings.EqualFold(s, "closed")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isDigits(s string) bool {
+	for i := range len(s) {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,273 @@
+package timerange_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+func mustParseWeeklySchedule(t *testing.T, input string, loc *time.Location) *timerange.WeeklySchedule {
+	t.Helper()
+	s, err := timerange.ParseWeeklySchedule(input, loc)
+	if err != nil {
+		t.Fatalf("ParseWeeklySchedule error: %s", err)
+	}
+	return s
+}
+
+func TestParseWeeklySchedule(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	// 2026-10-19 is Monday
+	monday := timerange.NewDate(2026, 10, 19)
+	for _, c := range []struct {
+		input string
+		want  [7][]string
+	}{
+		{"Mo-Fr 09:00-18:00; Sa 10:00-14:00", [7][]string{
+			nil,
+			{"09:00-18:00"}, {"09:00-18:00"}, {"09:00-18:00"}, {"09:00-18:00"}, {"09:00-18:00"},
+			{"10:00-14:00"},
+		}},
+		{"Mo,We 09:00-12:00,13:00-18:00", [7][]string{
+			nil,
+			{"09:00-12:00", "13:00-18:00"}, nil, {"09:00-12:00", "13:00-18:00"}, nil, nil,
+			nil,
+		}},
+		{"Fr-Mo 22:00-02:00", [7][]string{
+			{"22:00-02:00"},
+			{"22:00-02:00"}, nil, nil, nil, {"22:00-02:00"},
+			{"22:00-02:00"},
+		}},
+		{"10:00-20:00; Su off", [7][]string{
+			nil,
+			{"10:00-20:00"}, {"10:00-20:00"}, {"10:00-20:00"}, {"10:00-20:00"}, {"10:00-20:00"},
+			{"10:00-20:00"},
+		}},
+		{"24/7; Tu closed", [7][]string{
+			{"00:00-24:00"},
+			{"00:00-24:00"}, nil, {"00:00-24:00"}, {"00:00-24:00"}, {"00:00-24:00"},
+			{"00:00-24:00"},
+		}},
+		{"Sa", [7][]string{
+			nil,
+			nil, nil, nil, nil, nil,
+			{"00:00-24:00"},
+		}},
+	} {
+		t.Run(c.input, func(t *testing.T) {
+			s := mustParseWeeklySchedule(t, c.input, jst)
+			var got [7][]string
+			for i := range 7 {
+				d := monday.AddDays(i)
+				for _, w := range s.WindowsOn(d) {
+					got[d.Weekday()] = append(got[d.Weekday()], w.String())
+				}
+			}
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("date override", func(t *testing.T) {
+		s := mustParseWeeklySchedule(t, "Mo-Fr 09:00-18:00; 2026 Dec 25 off; 2026 Dec 26 10:00-12:00", jst)
+		if got := s.WindowsOn(timerange.NewDate(2026, 12, 25)); len(got) != 0 {
+			t.Errorf("want closed but was %v", got)
+		}
+		if got := s.WindowsOn(timerange.NewDate(2026, 12, 26)); len(got) != 1 || got[0].String() != "10:00-12:00" {
+			t.Errorf("want 10:00-12:00 but was %v", got)
+		}
+		if got := s.WindowsOn(timerange.NewDate(2026, 12, 24)); len(got) != 1 || got[0].String() != "09:00-18:00" {
+			t.Errorf("want 09:00-18:00 but was %v", got)
+		}
+	})
+
+	for _, c := range []struct {
+		input      string
+		wantToken  string
+		wantOffset int
+	}{
+		{"", "", 0},
+		{"Mo-Fr 09:00-18:00;", "", 18},
+		{"Xx 09:00-18:00", "Xx", 0},
+		{"Mo-Xx 09:00-18:00", "Mo-Xx", 0},
+		{"Mo 09:00-25:00", "09:00-25:00", 3},
+		{"Mo 09:00-18:00 Tu", "Tu", 15},
+		{"2026 Foo 25 off", "2026", 0},
+		{"2026 Dec", "", 8},
+	} {
+		t.Run(c.input, func(t *testing.T) {
+			_, err := timerange.ParseWeeklySchedule(c.input, jst)
+			var parseError *timerange.ParseError
+			if !errors.As(err, &parseError) {
+				t.Fatalf("want ParseError but was %#v", err)
+			}
+			if c.wantToken != parseError.Token {
+				t.Errorf("Token wants %q but was %q", c.wantToken, parseError.Token)
+			}
+			if c.wantOffset != parseError.Offset {
+				t.Errorf("Offset wants %d but was %d", c.wantOffset, parseError.Offset)
+			}
+		})
+	}
+}
+
+func TestWeeklySchedule_IsOpen(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	s := mustParseWeeklySchedule(t, "Mo-Fr 09:00-18:00; Sa 22:00-02:00; 2026 Oct 20 off", jst)
+	for _, c := range []struct {
+		t    time.Time
+		want bool
+	}{
+		{time.Date(2026, 10, 19, 8, 59, 0, 0, jst), false},
+		{time.Date(2026, 10, 19, 9, 0, 0, 0, jst), true},
+		{time.Date(2026, 10, 19, 18, 0, 0, 0, jst), true},
+		{time.Date(2026, 10, 19, 18, 0, 1, 0, jst), false},
+		{time.Date(2026, 10, 19, 0, 30, 0, 0, time.UTC), true},
+		{time.Date(2026, 10, 20, 12, 0, 0, 0, jst), false},
+		{time.Date(2026, 10, 24, 23, 0, 0, 0, jst), true},
+		{time.Date(2026, 10, 25, 1, 0, 0, 0, jst), true},
+		{time.Date(2026, 10, 25, 3, 0, 0, 0, jst), false},
+	} {
+		t.Run(c.t.String(), func(t *testing.T) {
+			if got := s.IsOpen(c.t); c.want != got {
+				t.Errorf("want %v but was %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestWeeklySchedule_NextOpen(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	s := mustParseWeeklySchedule(t, "Mo-Fr 09:00-18:00; 2026 Oct 20 off", jst)
+	for _, c := range []struct {
+		name       string
+		t          time.Time
+		wantOpen   time.Time
+		wantClose  time.Time
+		wantIsOpen bool
+	}{
+		{"before opening",
+			time.Date(2026, 10, 19, 8, 0, 0, 0, jst),
+			time.Date(2026, 10, 19, 9, 0, 0, 0, jst),
+			time.Date(2026, 10, 19, 18, 0, 0, 0, jst),
+			false,
+		},
+		{"open",
+			time.Date(2026, 10, 19, 12, 0, 0, 0, jst),
+			time.Date(2026, 10, 19, 12, 0, 0, 0, jst),
+			time.Date(2026, 10, 19, 18, 0, 0, 0, jst),
+			true,
+		},
+		{"skip the holiday",
+			time.Date(2026, 10, 19, 19, 0, 0, 0, jst),
+			time.Date(2026, 10, 21, 9, 0, 0, 0, jst),
+			time.Date(2026, 10, 21, 18, 0, 0, 0, jst),
+			false,
+		},
+		{"skip the weekend",
+			time.Date(2026, 10, 23, 19, 0, 0, 0, jst),
+			time.Date(2026, 10, 26, 9, 0, 0, 0, jst),
+			time.Date(2026, 10, 26, 18, 0, 0, 0, jst),
+			false,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := s.IsOpen(c.t); c.wantIsOpen != got {
+				t.Errorf("IsOpen wants %v but was %v", c.wantIsOpen, got)
+			}
+			gotOpen, ok := s.NextOpen(c.t)
+			if !ok {
+				t.Fatalf("NextOpen returned false")
+			}
+			if !c.wantOpen.Equal(gotOpen) {
+				t.Errorf("NextOpen wants %v != got %v", c.wantOpen, gotOpen)
+			}
+			gotClose, ok := s.NextClose(c.t)
+			if !ok {
+				t.Fatalf("NextClose returned false")
+			}
+			if !c.wantClose.Equal(gotClose) {
+				t.Errorf("NextClose wants %v != got %v", c.wantClose, gotClose)
+			}
+		})
+	}
+
+	t.Run("never closes", func(t *testing.T) {
+		s := mustParseWeeklySchedule(t, "24/7", jst)
+		now := time.Date(2026, 10, 19, 12, 0, 0, 0, jst)
+		if got, ok := s.NextOpen(now); !ok || !now.Equal(got) {
+			t.Errorf("NextOpen wants %v but was %v, %v", now, got, ok)
+		}
+		if got, ok := s.NextClose(now); ok {
+			t.Errorf("NextClose wants false but was %v", got)
+		}
+	})
+	t.Run("never opens", func(t *testing.T) {
+		s := timerange.NewWeeklySchedule(jst)
+		now := time.Date(2026, 10, 19, 12, 0, 0, 0, jst)
+		if got, ok := s.NextOpen(now); ok {
+			t.Errorf("NextOpen wants false but was %v", got)
+		}
+		if got, ok := s.NextClose(now); ok {
+			t.Errorf("NextClose wants false but was %v", got)
+		}
+	})
+}
+
+func TestWeeklySchedule_Ranges(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	t.Run("clipped", func(t *testing.T) {
+		s := mustParseWeeklySchedule(t, "Mo-Fr 09:00-18:00; Sa 10:00-14:00", jst)
+		over := timerange.New(
+			time.Date(2026, 10, 23, 12, 0, 0, 0, jst),
+			time.Date(2026, 10, 26, 10, 0, 0, 0, jst),
+		)
+		want := []timerange.TimeRange{
+			timerange.New(time.Date(2026, 10, 23, 12, 0, 0, 0, jst), time.Date(2026, 10, 23, 18, 0, 0, 0, jst)),
+			timerange.New(time.Date(2026, 10, 24, 10, 0, 0, 0, jst), time.Date(2026, 10, 24, 14, 0, 0, 0, jst)),
+			timerange.New(time.Date(2026, 10, 26, 9, 0, 0, 0, jst), time.Date(2026, 10, 26, 10, 0, 0, 0, jst)),
+		}
+		if diff := cmp.Diff(want, s.Ranges(over), equateTimeRange); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("merged across midnight", func(t *testing.T) {
+		s := mustParseWeeklySchedule(t, "Fr 18:00-24:00; Sa", jst)
+		s.Override(timerange.NewDate(2026, 10, 25), timerange.NewTimeOfDayRange(
+			timerange.TimeOfDay{}, timerange.TimeOfDay{Hour: 3},
+		))
+		over := timerange.New(
+			time.Date(2026, 10, 19, 0, 0, 0, 0, jst),
+			time.Date(2026, 10, 26, 0, 0, 0, 0, jst),
+		)
+		want := []timerange.TimeRange{
+			timerange.New(time.Date(2026, 10, 23, 18, 0, 0, 0, jst), time.Date(2026, 10, 25, 3, 0, 0, 0, jst)),
+		}
+		if diff := cmp.Diff(want, s.Ranges(over), equateTimeRange); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("daylight saving time", func(t *testing.T) {
+		newYork, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Fatalf("LoadLocation error: %s", err)
+		}
+		s := mustParseWeeklySchedule(t, "Su 00:00-06:00", newYork)
+		over := timerange.New(
+			time.Date(2026, 11, 1, 0, 0, 0, 0, newYork),
+			time.Date(2026, 11, 2, 0, 0, 0, 0, newYork),
+		)
+		got := s.Ranges(over)
+		if len(got) != 1 {
+			t.Fatalf("want 1 range but was %v", got)
+		}
+		// 2026-11-01 has an extra hour in New York
+		if want := 7 * time.Hour; want != got[0].Duration() {
+			t.Errorf("Duration wants %v but was %v", want, got[0].Duration())
+		}
+	})
+}
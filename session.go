@@ -0,0 +1,78 @@
+package timerange
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// SessionWindow groups times into sessions separated by inactivity.
+type SessionWindow struct {
+	// Timeout is the maximum gap between times in a session.
+	// A gap longer than this starts a new session.
+	Timeout time.Duration
+
+	// MaxLength is the maximum duration of a session.
+	// If a time exceeds it, a new session starts from the time.
+	// If zero, a session has no limit.
+	MaxLength time.Duration
+
+	// MaxDelay is the bounded out-of-orderness of the times.
+	// A time may arrive up to this duration after a later time.
+	// It is held until a time later than it by this duration arrives.
+	// A time which arrives later than this and before the current session is ignored.
+	// If zero, the times must be sorted.
+	MaxDelay time.Duration
+}
+
+// Sessions returns an iterator of sessions from the times.
+// Each session starts at the first time and ends at the last time of the session.
+func (w SessionWindow) Sessions(times iter.Seq[time.Time]) iter.Seq[TimeRange] {
+	return func(yield func(TimeRange) bool) {
+		var pending []time.Time
+		var session TimeRange
+		var started bool
+		var latest time.Time
+		// add extends the session or yields it to start a new session.
+		add := func(t time.Time) bool {
+			if started {
+				if !t.After(session.end) {
+					return true
+				}
+				if t.Sub(session.end) <= w.Timeout && (w.MaxLength == 0 || t.Sub(session.start) <= w.MaxLength) {
+					session.end = t
+					return true
+				}
+				if !yield(session) {
+					return false
+				}
+			}
+			session, started = TimeRange{start: t, end: t}, true
+			return true
+		}
+		for t := range times {
+			if started && t.Before(session.start) {
+				continue
+			}
+			i, _ := slices.BinarySearchFunc(pending, t, time.Time.Compare)
+			pending = slices.Insert(pending, i, t)
+			latest = maxTime(latest, t)
+			watermark := latest.Add(-w.MaxDelay)
+			var n int
+			for ; n < len(pending) && !pending[n].After(watermark); n++ {
+				if !add(pending[n]) {
+					return
+				}
+			}
+			pending = slices.Delete(pending, 0, n)
+		}
+		for _, t := range pending {
+			if !add(t) {
+				return
+			}
+		}
+		if started {
+			yield(session)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package timerange_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+func TestSessionWindow_Sessions(t *testing.T) {
+	t.Run("sorted", func(t *testing.T) {
+		w := timerange.SessionWindow{Timeout: 10 * time.Minute}
+		times := []time.Time{
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 5, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+		}
+		got := slices.Collect(w.Sessions(slices.Values(times)))
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("max length", func(t *testing.T) {
+		w := timerange.SessionWindow{Timeout: 10 * time.Minute, MaxLength: 10 * time.Minute}
+		times := []time.Time{
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 5, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+		}
+		got := slices.Collect(w.Sessions(slices.Values(times)))
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 15, 0, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("out of order within max delay", func(t *testing.T) {
+		w := timerange.SessionWindow{Timeout: 10 * time.Minute, MaxDelay: 30 * time.Minute}
+		times := []time.Time{
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 25, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 20, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+		}
+		got := slices.Collect(w.Sessions(slices.Values(times)))
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 25, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 16, 0, 0, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("out of order beyond max delay", func(t *testing.T) {
+		w := timerange.SessionWindow{Timeout: 10 * time.Minute}
+		times := []time.Time{
+			time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 10, 0, 0, time.UTC),
+		}
+		got := slices.Collect(w.Sessions(slices.Values(times)))
+		want := []timerange.TimeRange{
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 0, 0, 0, time.UTC),
+			),
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+				time.Date(2006, 1, 2, 15, 30, 0, 0, time.UTC),
+			),
+		}
+		if diff := cmp.Diff(want, got, equateTimeRange); diff != "" {
+			t.Errorf("want != got\n%s", diff)
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		w := timerange.SessionWindow{Timeout: 10 * time.Minute}
+		got := slices.Collect(w.Sessions(slices.Values([]time.Time(nil))))
+		if len(got) != 0 {
+			t.Errorf("want empty but was %v", got)
+		}
+	})
+}
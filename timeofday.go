@@ -0,0 +1,258 @@
+package timerange
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeOfDay represents a wall clock time without date and location.
+// Hour is in [0, 24], where 24:00:00 represents the end of a day.
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+	Second int
+}
+
+// TimeOfDayOf returns the TimeOfDay of the time in its location.
+// Nanoseconds are truncated.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	hour, minute, second := t.Clock()
+	return TimeOfDay{Hour: hour, Minute: minute, Second: second}
+}
+
+// ParseTimeOfDay parses a time of day in the form of "15:04" or "15:04:05".
+// "24:00" is accepted as the end of a day.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day %q: want HH:MM or HH:MM:SS", s)
+	}
+	var values [3]int
+	for i, part := range parts {
+		if len(part) != 2 {
+			return TimeOfDay{}, fmt.Errorf("invalid time of day %q: want HH:MM or HH:MM:SS", s)
+		}
+		v, err := strconv.Atoi(part)
+		if err != nil || v < 0 {
+			return TimeOfDay{}, fmt.Errorf("invalid time of day %q: want HH:MM or HH:MM:SS", s)
+		}
+		values[i] = v
+	}
+	tod := TimeOfDay{Hour: values[0], Minute: values[1], Second: values[2]}
+	if !tod.valid() {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day %q: out of range", s)
+	}
+	return tod, nil
+}
+
+func (t TimeOfDay) valid() bool {
+	if t.Hour == 24 {
+		return t.Minute == 0 && t.Second == 0
+	}
+	return t.Hour >= 0 && t.Hour < 24 && t.Minute >= 0 && t.Minute < 60 && t.Second >= 0 && t.Second < 60
+}
+
+// String returns the time of day in the form of "15:04", or "15:04:05" if it has seconds.
+func (t TimeOfDay) String() string {
+	if t.Second != 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	}
+	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+}
+
+// Duration returns the duration since the midnight, regardless of daylight saving time.
+func (t TimeOfDay) Duration() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute + time.Duration(t.Second)*time.Second
+}
+
+// Compare returns -1 if this is before x, +1 if after x, or 0 if the same.
+func (t TimeOfDay) Compare(x TimeOfDay) int {
+	switch d, dx := t.Duration(), x.Duration(); {
+	case d < dx:
+		return -1
+	case d > dx:
+		return 1
+	}
+	return 0
+}
+
+// NewTimeOfDayRange returns a TimeOfDayRange with start time and end time.
+// If end < start, the range wraps past the midnight, such as 22:00–02:00.
+func NewTimeOfDayRange(start, end TimeOfDay) TimeOfDayRange {
+	return TimeOfDayRange{start: start, end: end}
+}
+
+// ParseTimeOfDayRange parses a range in the form of "22:00-02:00".
+// An en dash is also accepted as the separator.
+func ParseTimeOfDayRange(s string) (TimeOfDayRange, error) {
+	startText, endText, ok := strings.Cut(s, "-")
+	if !ok {
+		startText, endText, ok = strings.Cut(s, "–")
+	}
+	if !ok {
+		return TimeOfDayRange{}, fmt.Errorf("invalid time of day range %q: missing separator -", s)
+	}
+	start, err := ParseTimeOfDay(strings.TrimSpace(startText))
+	if err != nil {
+		return TimeOfDayRange{}, fmt.Errorf("invalid start of time of day range: %w", err)
+	}
+	end, err := ParseTimeOfDay(strings.TrimSpace(endText))
+	if err != nil {
+		return TimeOfDayRange{}, fmt.Errorf("invalid end of time of day range: %w", err)
+	}
+	return NewTimeOfDayRange(start, end), nil
+}
+
+// TimeOfDayRange represents a daily window of wall clock times, such as opening hours.
+// The range includes start time and end time, i.e., [start, end].
+// If the end time is before the start time, the range wraps past the midnight.
+type TimeOfDayRange struct {
+	start TimeOfDay
+	end   TimeOfDay
+}
+
+// Start returns the start time.
+func (r TimeOfDayRange) Start() TimeOfDay {
+	return r.start
+}
+
+// End returns the end time.
+func (r TimeOfDayRange) End() TimeOfDay {
+	return r.end
+}
+
+// String returns a string representation in the form of "22:00-02:00".
+func (r TimeOfDayRange) String() string {
+	return r.start.String() + "-" + r.end.String()
+}
+
+// Wraps returns true if this range wraps past the midnight.
+func (r TimeOfDayRange) Wraps() bool {
+	return r.end.Compare(r.start) < 0
+}
+
+// Duration returns the nominal duration of this range, regardless of daylight saving time.
+func (r TimeOfDayRange) Duration() time.Duration {
+	if r.Wraps() {
+		return 24*time.Hour - r.start.Duration() + r.end.Duration()
+	}
+	return r.end.Duration() - r.start.Duration()
+}
+
+// Contains returns true if the wall clock time of t in the location is within this range.
+// If the end is 24:00, the midnight is contained as the end of the day, as well as On.
+func (r TimeOfDayRange) Contains(t time.Time, loc *time.Location) bool {
+	t = t.In(loc)
+	d := TimeOfDayOf(t).Duration() + time.Duration(t.Nanosecond())
+	start, end := r.start.Duration(), r.end.Duration()
+	if d == 0 && end == 24*time.Hour {
+		return true
+	}
+	if r.Wraps() {
+		return d >= start || d <= end
+	}
+	return d >= start && d <= end
+}
+
+// On returns a TimeRange of this range on the date in the location.
+// If this range wraps past the midnight, it ends on the next day.
+//
+// On a transition of daylight saving time, the range covers all instants
+// whose wall clock time is within this range.
+// If the start or end time does not exist due to a gap, such as 02:30 in spring forward,
+// it is replaced with the instant of the transition.
+// If the start or end time occurs twice due to a fold, such as 01:30 in fall back,
+// the earlier instant is used for the start and the later instant for the end.
+func (r TimeOfDayRange) On(d Date, loc *time.Location) TimeRange {
+	start, _ := wallClockOn(d, r.start, loc)
+	endDate := d
+	if r.Wraps() {
+		endDate = d.AddDays(1)
+	}
+	_, end := wallClockOn(endDate, r.end, loc)
+	return New(start, end)
+}
+
+// Windows returns an iterator over the daily windows of this range which overlap the TimeRange.
+// Each window is clipped to the TimeRange.
+// The windows are computed in the location, as described in On.
+func (r TimeOfDayRange) Windows(over TimeRange, loc *time.Location) iter.Seq[TimeRange] {
+	return func(yield func(TimeRange) bool) {
+		if over.IsZero() {
+			return
+		}
+		// A window of the previous day may wrap into the first day.
+		first := DateOf(over.start.In(loc)).AddDays(-1)
+		last := DateOf(over.end.In(loc))
+		for d := first; !d.After(last); d = d.AddDays(1) {
+			window := r.On(d, loc)
+			if window.IsZero() {
+				continue
+			}
+			clipped := Intersect(window, over)
+			if clipped.IsZero() {
+				continue
+			}
+			// Skip a window which only touches the boundary of the TimeRange.
+			if clipped.Duration() == 0 && window.Duration() > 0 {
+				continue
+			}
+			if !yield(clipped) {
+				return
+			}
+		}
+	}
+}
+
+// wallClockOn returns the instants of the wall clock time on the date in the location.
+// If the time occurs twice due to a fold, it returns the earlier and later instants.
+// If the time does not exist due to a gap, both are the instant of the transition.
+func wallClockOn(d Date, tod TimeOfDay, loc *time.Location) (earlier, later time.Time) {
+	// Seconds of the wall clock time as if it were in UTC
+	wall := d.In(time.UTC).Add(tod.Duration()).Unix()
+	t := time.Date(d.Year, d.Month, d.Day, tod.Hour, tod.Minute, tod.Second, 0, loc)
+	zoneStart, zoneEnd := t.ZoneBounds()
+	offsets := []int{offsetOf(t)}
+	if !zoneStart.IsZero() {
+		offsets = append(offsets, offsetOf(zoneStart.Add(-time.Nanosecond)))
+	}
+	if !zoneEnd.IsZero() {
+		offsets = append(offsets, offsetOf(zoneEnd))
+	}
+
+	for _, offset := range offsets {
+		c := time.Unix(wall-int64(offset), 0).In(loc)
+		if offsetOf(c) != offset {
+			continue
+		}
+		if earlier.IsZero() || c.Before(earlier) {
+			earlier = c
+		}
+		if later.IsZero() || c.After(later) {
+			later = c
+		}
+	}
+	if !earlier.IsZero() {
+		return earlier, later
+	}
+
+	// The wall clock time is in a gap
+	for _, transition := range []time.Time{zoneStart, zoneEnd} {
+		if transition.IsZero() {
+			continue
+		}
+		before, after := offsetOf(transition.Add(-time.Nanosecond)), offsetOf(transition)
+		if transition.Unix()+int64(before) <= wall && wall < transition.Unix()+int64(after) {
+			return transition, transition
+		}
+	}
+	return t, t
+}
+
+func offsetOf(t time.Time) int {
+	_, offset := t.Zone()
+	return offset
+}
@@ -0,0 +1,222 @@
+package timerange_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	for _, c := range []struct {
+		s    string
+		want timerange.TimeOfDay
+	}{
+		{"00:00", timerange.TimeOfDay{}},
+		{"15:04", timerange.TimeOfDay{Hour: 15, Minute: 4}},
+		{"15:04:05", timerange.TimeOfDay{Hour: 15, Minute: 4, Second: 5}},
+		{"24:00", timerange.TimeOfDay{Hour: 24}},
+	} {
+		t.Run(c.s, func(t *testing.T) {
+			got, err := timerange.ParseTimeOfDay(c.s)
+			if err != nil {
+				t.Fatalf("ParseTimeOfDay error: %s", err)
+			}
+			if c.want != got {
+				t.Errorf("want %v != got %v", c.want, got)
+			}
+			if c.s != got.String() {
+				t.Errorf("String wants %s but was %s", c.s, got.String())
+			}
+		})
+	}
+	for _, s := range []string{"", "15", "5:04", "15:4", "24:01", "25:00", "15:60", "15:04:60", "-1:00", "15:04:05:00"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := timerange.ParseTimeOfDay(s); err == nil {
+				t.Errorf("want error but was nil")
+			}
+		})
+	}
+}
+
+func TestParseTimeOfDayRange(t *testing.T) {
+	for _, s := range []string{"22:00-02:00", "22:00–02:00", "22:00 - 02:00"} {
+		t.Run(s, func(t *testing.T) {
+			got, err := timerange.ParseTimeOfDayRange(s)
+			if err != nil {
+				t.Fatalf("ParseTimeOfDayRange error: %s", err)
+			}
+			if want := "22:00-02:00"; want != got.String() {
+				t.Errorf("want %s but was %s", want, got.String())
+			}
+		})
+	}
+	for _, s := range []string{"", "22:00", "22:00-", "x-02:00"} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := timerange.ParseTimeOfDayRange(s); err == nil {
+				t.Errorf("want error but was nil")
+			}
+		})
+	}
+}
+
+func mustParseTimeOfDayRange(t *testing.T, s string) timerange.TimeOfDayRange {
+	t.Helper()
+	r, err := timerange.ParseTimeOfDayRange(s)
+	if err != nil {
+		t.Fatalf("ParseTimeOfDayRange error: %s", err)
+	}
+	return r
+}
+
+func TestTimeOfDayRange_Duration(t *testing.T) {
+	for _, c := range []struct {
+		s     string
+		wraps bool
+		want  time.Duration
+	}{
+		{"09:00-17:00", false, 8 * time.Hour},
+		{"22:00-02:00", true, 4 * time.Hour},
+		{"00:00-24:00", false, 24 * time.Hour},
+		{"12:00-12:00", false, 0},
+	} {
+		t.Run(c.s, func(t *testing.T) {
+			r := mustParseTimeOfDayRange(t, c.s)
+			if got := r.Wraps(); c.wraps != got {
+				t.Errorf("Wraps wants %v but was %v", c.wraps, got)
+			}
+			if got := r.Duration(); c.want != got {
+				t.Errorf("Duration wants %v but was %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayRange_Contains(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	for _, c := range []struct {
+		s    string
+		t    time.Time
+		want bool
+	}{
+		{"09:00-17:00", time.Date(2026, 10, 18, 9, 0, 0, 0, jst), true},
+		{"09:00-17:00", time.Date(2026, 10, 18, 17, 0, 0, 0, jst), true},
+		{"09:00-17:00", time.Date(2026, 10, 18, 17, 0, 0, 1, jst), false},
+		{"09:00-17:00", time.Date(2026, 10, 18, 8, 59, 59, 0, jst), false},
+		{"09:00-17:00", time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC), true},
+		{"22:00-02:00", time.Date(2026, 10, 18, 23, 0, 0, 0, jst), true},
+		{"22:00-02:00", time.Date(2026, 10, 18, 1, 0, 0, 0, jst), true},
+		{"22:00-02:00", time.Date(2026, 10, 18, 12, 0, 0, 0, jst), false},
+		{"00:00-24:00", time.Date(2026, 10, 18, 23, 59, 59, 999999999, jst), true},
+		{"22:00-24:00", time.Date(2026, 10, 19, 0, 0, 0, 0, jst), true},
+		{"22:00-24:00", time.Date(2026, 10, 19, 0, 0, 0, 1, jst), false},
+	} {
+		t.Run(c.s+" "+c.t.String(), func(t *testing.T) {
+			if got := mustParseTimeOfDayRange(t, c.s).Contains(c.t, jst); c.want != got {
+				t.Errorf("want %v but was %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayRange_Contains_On(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	for _, s := range []string{"09:00-17:00", "22:00-02:00", "22:00-24:00", "00:00-24:00"} {
+		t.Run(s, func(t *testing.T) {
+			r := mustParseTimeOfDayRange(t, s)
+			on := r.On(timerange.NewDate(2026, 10, 18), jst)
+			for _, tm := range []time.Time{on.Start(), on.End()} {
+				if !r.Contains(tm, jst) {
+					t.Errorf("Contains(%v) wants true as %v contains it", tm, on)
+				}
+			}
+		})
+	}
+}
+
+func TestTimeOfDayRange_On(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %s", err)
+	}
+	for _, c := range []struct {
+		name string
+		s    string
+		d    timerange.Date
+		want timerange.TimeRange
+	}{
+		{"regular", "09:00-17:00", timerange.NewDate(2026, 10, 18), timerange.New(
+			time.Date(2026, 10, 18, 9, 0, 0, 0, newYork),
+			time.Date(2026, 10, 18, 17, 0, 0, 0, newYork),
+		)},
+		{"wrap", "22:00-02:00", timerange.NewDate(2026, 10, 18), timerange.New(
+			time.Date(2026, 10, 18, 22, 0, 0, 0, newYork),
+			time.Date(2026, 10, 19, 2, 0, 0, 0, newYork),
+		)},
+		{"whole day", "00:00-24:00", timerange.NewDate(2026, 10, 18), timerange.New(
+			time.Date(2026, 10, 18, 0, 0, 0, 0, newYork),
+			time.Date(2026, 10, 19, 0, 0, 0, 0, newYork),
+		)},
+		// 2026-03-08 02:00 EST is followed by 03:00 EDT
+		{"start in gap", "02:30-04:00", timerange.NewDate(2026, 3, 8), timerange.New(
+			time.Date(2026, 3, 8, 7, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 8, 8, 0, 0, 0, time.UTC),
+		)},
+		{"end in gap", "01:00-02:30", timerange.NewDate(2026, 3, 8), timerange.New(
+			time.Date(2026, 3, 8, 6, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 8, 7, 0, 0, 0, time.UTC),
+		)},
+		// 2026-11-01 02:00 EDT is followed by 01:00 EST
+		{"start in fold", "01:30-03:00", timerange.NewDate(2026, 11, 1), timerange.New(
+			time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC),
+			time.Date(2026, 11, 1, 8, 0, 0, 0, time.UTC),
+		)},
+		{"end in fold", "00:00-01:30", timerange.NewDate(2026, 11, 1), timerange.New(
+			time.Date(2026, 11, 1, 4, 0, 0, 0, time.UTC),
+			time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC),
+		)},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			got := mustParseTimeOfDayRange(t, c.s).On(c.d, newYork)
+			if !c.want.Equal(got) {
+				t.Errorf("want %v != got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayRange_Windows(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	r := mustParseTimeOfDayRange(t, "22:00-02:00")
+	t.Run("over days", func(t *testing.T) {
+		over := timerange.New(
+			time.Date(2026, 10, 18, 1, 0, 0, 0, jst),
+			time.Date(2026, 10, 20, 23, 0, 0, 0, jst),
+		)
+		want := []timerange.TimeRange{
+			timerange.New(time.Date(2026, 10, 18, 1, 0, 0, 0, jst), time.Date(2026, 10, 18, 2, 0, 0, 0, jst)),
+			timerange.New(time.Date(2026, 10, 18, 22, 0, 0, 0, jst), time.Date(2026, 10, 19, 2, 0, 0, 0, jst)),
+			timerange.New(time.Date(2026, 10, 19, 22, 0, 0, 0, jst), time.Date(2026, 10, 20, 2, 0, 0, 0, jst)),
+			timerange.New(time.Date(2026, 10, 20, 22, 0, 0, 0, jst), time.Date(2026, 10, 20, 23, 0, 0, 0, jst)),
+		}
+		if diff := cmp.Diff(want, slices.Collect(r.Windows(over, jst)), equateTimeRange); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("touching boundary", func(t *testing.T) {
+		over := timerange.New(
+			time.Date(2026, 10, 18, 2, 0, 0, 0, jst),
+			time.Date(2026, 10, 18, 22, 0, 0, 0, jst),
+		)
+		if got := slices.Collect(r.Windows(over, jst)); len(got) != 0 {
+			t.Errorf("want empty but was %v", got)
+		}
+	})
+	t.Run("zero", func(t *testing.T) {
+		if got := slices.Collect(r.Windows(timerange.TimeRange{}, jst)); len(got) != 0 {
+			t.Errorf("want empty but was %v", got)
+		}
+	})
+}
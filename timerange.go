@@ -3,6 +3,7 @@ package timerange
 
 import (
 	"fmt"
+	"log/slog"
 	"time"
 )
 
@@ -31,6 +32,11 @@ func Until(end time.Time, duration time.Duration) TimeRange {
 // TimeRange represents an immutable range of time with timezone.
 // The range includes start time and end time, i.e., [start, end].
 // Start time must be earlier than end time.
+//
+// If both operands have the monotonic clock readings, such as returned by time.Now,
+// Equal, Contains, Duration and Intersect compare them by the monotonic clock.
+// Otherwise, they compare by the wall clock.
+// See IsMonotonic and StripMonotonic.
 type TimeRange struct {
 	start time.Time
 	end   time.Time
@@ -51,6 +57,58 @@ func (r TimeRange) String() string {
 	return fmt.Sprintf("[%s, %s]", r.start.Format(time.RFC3339), r.end.Format(time.RFC3339))
 }
 
+// Format implements fmt.Formatter.
+// %v and %s print the same as String().
+// %+v also prints the duration and location.
+// %#v prints a Go expression which constructs this range.
+func (r TimeRange) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		_, _ = fmt.Fprint(f, r.goString())
+	case verb == 'v' && f.Flag('+'):
+		location := r.start.Location().String()
+		if end := r.end.Location().String(); end != location {
+			location += " and " + end
+		}
+		_, _ = fmt.Fprintf(f, "%s (duration %s, location %s)", r.String(), r.Duration(), location)
+	case verb == 'v' || verb == 's' || verb == 'q':
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), r.String())
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(timerange.TimeRange=%s)", verb, r.String())
+	}
+}
+
+func (r TimeRange) goString() string {
+	if r.IsZero() {
+		return "timerange.TimeRange{}"
+	}
+	return fmt.Sprintf("timerange.New(%s, %s)", goStringTime(r.start), goStringTime(r.end))
+}
+
+func goStringTime(t time.Time) string {
+	loc := "time.UTC"
+	switch t.Location() {
+	case time.UTC:
+	case time.Local:
+		loc = "time.Local"
+	default:
+		name, offset := t.Zone()
+		loc = fmt.Sprintf("time.FixedZone(%q, %d)", name, offset)
+	}
+	return fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, %d, %s)",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// LogValue implements slog.LogValuer.
+// It returns a group of start time, end time and duration.
+func (r TimeRange) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Time("start", r.start),
+		slog.Time("end", r.end),
+		slog.Duration("duration", r.Duration()),
+	)
+}
+
 // Equal returns true if this range is equivalent to one.
 func (r TimeRange) Equal(x TimeRange) bool {
 	return r.start.Equal(x.start) && r.end.Equal(x.end)
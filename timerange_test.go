@@ -1,6 +1,9 @@
 package timerange_test
 
 import (
+	"bytes"
+	"fmt"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -104,6 +107,73 @@ func TestTimeRange_String(t *testing.T) {
 	}
 }
 
+func TestTimeRange_Format(t *testing.T) {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	jst := time.FixedZone("JST", 9*60*60)
+
+	for _, c := range []struct {
+		format string
+		r      timerange.TimeRange
+		want   string
+	}{
+		{"%v", r, "[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]"},
+		{"%s", r, "[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]"},
+		{"%q", r, `"[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]"`},
+		{"%+v", r, "[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z] (duration 3m0s, location UTC)"},
+		{
+			"%+v",
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+				time.Date(2006, 1, 3, 0, 7, 5, 0, jst),
+			),
+			"[2006-01-02T15:04:05Z, 2006-01-03T00:07:05+09:00] (duration 3m0s, location UTC and JST)",
+		},
+		{"%#v", r, "timerange.New(time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC), time.Date(2006, time.January, 2, 15, 7, 5, 0, time.UTC))"},
+		{
+			"%#v",
+			timerange.New(
+				time.Date(2006, 1, 2, 15, 4, 5, 6, jst),
+				time.Date(2006, 1, 2, 15, 7, 5, 0, jst),
+			),
+			`timerange.New(time.Date(2006, time.January, 2, 15, 4, 5, 6, time.FixedZone("JST", 32400)), time.Date(2006, time.January, 2, 15, 7, 5, 0, time.FixedZone("JST", 32400)))`,
+		},
+		{"%#v", timerange.TimeRange{}, "timerange.TimeRange{}"},
+		{"%d", r, "%!d(timerange.TimeRange=[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z])"},
+	} {
+		t.Run(c.format, func(t *testing.T) {
+			got := fmt.Sprintf(c.format, c.r)
+			if c.want != got {
+				t.Errorf("want %s but was %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeRange_LogValue(t *testing.T) {
+	r := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	var b bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&b, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("hello", "range", r)
+	got := b.String()
+	want := "level=INFO msg=hello range.start=2006-01-02T15:04:05.000Z range.end=2006-01-02T15:07:05.000Z range.duration=3m0s\n"
+	if want != got {
+		t.Errorf("want %s but was %s", want, got)
+	}
+}
+
 func TestTimeRange_Equal(t *testing.T) {
 	a := timerange.New(
 		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
@@ -0,0 +1,13 @@
+module github.com/int128/go-timerange/timerangepb
+
+// google.golang.org/genproto requires go 1.25.0,
+// while the root module supports go 1.23.
+go 1.25.0
+
+toolchain go1.26.5
+
+require (
+	github.com/int128/go-timerange v0.1.0
+	google.golang.org/genproto v0.0.0-20260825221802-da73d73af1c5
+	google.golang.org/protobuf v1.36.12
+)
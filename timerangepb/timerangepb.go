@@ -0,0 +1,95 @@
+// Package timerangepb provides conversions between timerange.TimeRange and Protocol Buffers messages.
+//
+// This is a separate module so that the root package does not depend on Protocol Buffers.
+package timerangepb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/int128/go-timerange"
+	"google.golang.org/genproto/googleapis/type/interval"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromTimestamps returns a TimeRange with the start and end timestamps.
+// Both timestamps must be valid and start <= end.
+// The returned range is in UTC.
+func FromTimestamps(start, end *timestamppb.Timestamp) (timerange.TimeRange, error) {
+	if err := start.CheckValid(); err != nil {
+		return timerange.TimeRange{}, fmt.Errorf("invalid start time: %w", err)
+	}
+	if err := end.CheckValid(); err != nil {
+		return timerange.TimeRange{}, fmt.Errorf("invalid end time: %w", err)
+	}
+	startTime, endTime := start.AsTime(), end.AsTime()
+	if startTime.After(endTime) {
+		return timerange.TimeRange{}, fmt.Errorf("start time %s is after end time %s", startTime, endTime)
+	}
+	return timerange.New(startTime, endTime), nil
+}
+
+// ToTimestamps returns the start and end timestamps of the TimeRange.
+// It returns an error if the range is out of the range of Timestamp,
+// that is, from 0001-01-01 to 9999-12-31.
+func ToTimestamps(r timerange.TimeRange) (*timestamppb.Timestamp, *timestamppb.Timestamp, error) {
+	start, end := timestamppb.New(r.Start()), timestamppb.New(r.End())
+	if err := start.CheckValid(); err != nil {
+		return nil, nil, fmt.Errorf("invalid start time: %w", err)
+	}
+	if err := end.CheckValid(); err != nil {
+		return nil, nil, fmt.Errorf("invalid end time: %w", err)
+	}
+	return start, end, nil
+}
+
+// FromInterval returns a TimeRange of the Interval.
+// Both start and end time must be set and valid, and start <= end.
+// The returned range is in UTC.
+//
+// Note that an Interval excludes the end time, while a TimeRange includes it.
+// This converts the times as they are.
+func FromInterval(i *interval.Interval) (timerange.TimeRange, error) {
+	if i == nil {
+		return timerange.TimeRange{}, errors.New("interval is nil")
+	}
+	return FromTimestamps(i.GetStartTime(), i.GetEndTime())
+}
+
+// ToInterval returns an Interval of the TimeRange.
+// See ToTimestamps for the validation.
+func ToInterval(r timerange.TimeRange) (*interval.Interval, error) {
+	start, end, err := ToTimestamps(r)
+	if err != nil {
+		return nil, err
+	}
+	return &interval.Interval{StartTime: start, EndTime: end}, nil
+}
+
+// FromIntervals returns TimeRanges of the repeated Intervals.
+// If any Interval is invalid, this returns an error with its index.
+func FromIntervals(intervals []*interval.Interval) ([]timerange.TimeRange, error) {
+	ranges := make([]timerange.TimeRange, 0, len(intervals))
+	for index, i := range intervals {
+		r, err := FromInterval(i)
+		if err != nil {
+			return nil, fmt.Errorf("intervals[%d]: %w", index, err)
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// ToIntervals returns repeated Intervals of the TimeRanges.
+// If any TimeRange is invalid, this returns an error with its index.
+func ToIntervals(ranges []timerange.TimeRange) ([]*interval.Interval, error) {
+	intervals := make([]*interval.Interval, 0, len(ranges))
+	for index, r := range ranges {
+		i, err := ToInterval(r)
+		if err != nil {
+			return nil, fmt.Errorf("ranges[%d]: %w", index, err)
+		}
+		intervals = append(intervals, i)
+	}
+	return intervals, nil
+}
@@ -0,0 +1,163 @@
+package timerangepb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+	"github.com/int128/go-timerange/timerangepb"
+	"google.golang.org/genproto/googleapis/type/interval"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFromInterval(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		got, err := timerangepb.FromInterval(&interval.Interval{
+			StartTime: &timestamppb.Timestamp{Seconds: 1136214245},
+			EndTime:   &timestamppb.Timestamp{Seconds: 1136214425, Nanos: 1},
+		})
+		if err != nil {
+			t.Fatalf("FromInterval error: %s", err)
+		}
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 1, time.UTC),
+		)
+		if !want.Equal(got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("start > end", func(t *testing.T) {
+		_, err := timerangepb.FromInterval(&interval.Interval{
+			StartTime: &timestamppb.Timestamp{Seconds: 1136214425},
+			EndTime:   &timestamppb.Timestamp{Seconds: 1136214245},
+		})
+		if err == nil {
+			t.Errorf("want error but was nil")
+		}
+	})
+	t.Run("missing end time", func(t *testing.T) {
+		_, err := timerangepb.FromInterval(&interval.Interval{
+			StartTime: &timestamppb.Timestamp{Seconds: 1136214245},
+		})
+		if err == nil {
+			t.Errorf("want error but was nil")
+		}
+	})
+	t.Run("invalid nanos", func(t *testing.T) {
+		_, err := timerangepb.FromInterval(&interval.Interval{
+			StartTime: &timestamppb.Timestamp{Seconds: 1136214245},
+			EndTime:   &timestamppb.Timestamp{Seconds: 1136214425, Nanos: 1e9},
+		})
+		if err == nil {
+			t.Errorf("want error but was nil")
+		}
+	})
+	t.Run("nil", func(t *testing.T) {
+		_, err := timerangepb.FromInterval(nil)
+		if err == nil {
+			t.Errorf("want error but was nil")
+		}
+	})
+}
+
+func TestToInterval(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		got, err := timerangepb.ToInterval(timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 1, time.UTC),
+		))
+		if err != nil {
+			t.Fatalf("ToInterval error: %s", err)
+		}
+		want := &interval.Interval{
+			StartTime: &timestamppb.Timestamp{Seconds: 1136214245},
+			EndTime:   &timestamppb.Timestamp{Seconds: 1136214425, Nanos: 1},
+		}
+		if !proto.Equal(want, got) {
+			t.Errorf("want %v != got %v", want, got)
+		}
+	})
+	t.Run("out of range", func(t *testing.T) {
+		_, err := timerangepb.ToInterval(timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+		))
+		if err == nil {
+			t.Errorf("want error but was nil")
+		}
+	})
+}
+
+func TestFromIntervals(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		got, err := timerangepb.FromIntervals([]*interval.Interval{
+			{StartTime: &timestamppb.Timestamp{Seconds: 1136214245}, EndTime: &timestamppb.Timestamp{Seconds: 1136214425}},
+			{StartTime: &timestamppb.Timestamp{Seconds: 1136214425}, EndTime: &timestamppb.Timestamp{Seconds: 1136214605}},
+		})
+		if err != nil {
+			t.Fatalf("FromIntervals error: %s", err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("want 2 ranges but was %d", len(got))
+		}
+		want := timerange.New(
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 10, 5, 0, time.UTC),
+		)
+		if !want.Equal(got[1]) {
+			t.Errorf("want %v != got %v", want, got[1])
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := timerangepb.FromIntervals([]*interval.Interval{
+			{StartTime: &timestamppb.Timestamp{Seconds: 1136214245}, EndTime: &timestamppb.Timestamp{Seconds: 1136214425}},
+			nil,
+		})
+		if err == nil {
+			t.Fatalf("want error but was nil")
+		}
+		if want := "intervals[1]: interval is nil"; err.Error() != want {
+			t.Errorf("want %q but was %q", want, err)
+		}
+	})
+}
+
+func TestToIntervals(t *testing.T) {
+	ranges := []timerange.TimeRange{
+		timerange.New(
+			time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+		),
+	}
+	intervals, err := timerangepb.ToIntervals(ranges)
+	if err != nil {
+		t.Fatalf("ToIntervals error: %s", err)
+	}
+	got, err := timerangepb.FromIntervals(intervals)
+	if err != nil {
+		t.Fatalf("FromIntervals error: %s", err)
+	}
+	if !ranges[0].Equal(got[0]) {
+		t.Errorf("want %v != got %v", ranges[0], got[0])
+	}
+}
+
+func TestFromTimestamps(t *testing.T) {
+	start, end, err := timerangepb.ToTimestamps(timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("JST", 9*60*60)),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.FixedZone("JST", 9*60*60)),
+	))
+	if err != nil {
+		t.Fatalf("ToTimestamps error: %s", err)
+	}
+	got, err := timerangepb.FromTimestamps(start, end)
+	if err != nil {
+		t.Fatalf("FromTimestamps error: %s", err)
+	}
+	want := "[2006-01-02T06:04:05Z, 2006-01-02T06:07:05Z]"
+	if got.String() != want {
+		t.Errorf("want %s but was %s", want, got)
+	}
+}
@@ -0,0 +1,67 @@
+package timerangetest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+// AssertEqual reports an error if the ranges are not equal.
+// The ranges are compared by the options, or IgnoreLocation if no option is given.
+func AssertEqual(t testing.TB, want, got timerange.TimeRange, opts ...cmp.Option) {
+	t.Helper()
+	if !cmp.Equal(want, got, defaultOptions(opts)...) {
+		t.Errorf("want %s != got %s", Format(want), Format(got))
+	}
+}
+
+// AssertEqualSlices reports an error if the slices of ranges are not equal.
+// The error message contains the diff of ranges in the form of "[start, end]".
+// The ranges are compared by the options, or IgnoreLocation if no option is given.
+func AssertEqualSlices(t testing.TB, want, got []timerange.TimeRange, opts ...cmp.Option) {
+	t.Helper()
+	if !cmp.Equal(want, got, defaultOptions(opts)...) {
+		t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(formatAll(want), formatAll(got)))
+	}
+}
+
+// AssertContains reports an error if the range does not contain the time.
+func AssertContains(t testing.TB, r timerange.TimeRange, tm time.Time) {
+	t.Helper()
+	if !r.Contains(tm) {
+		t.Errorf("%s does not contain %s", Format(r), tm.Format(time.RFC3339Nano))
+	}
+}
+
+// AssertNotContains reports an error if the range contains the time.
+func AssertNotContains(t testing.TB, r timerange.TimeRange, tm time.Time) {
+	t.Helper()
+	if r.Contains(tm) {
+		t.Errorf("%s contains %s", Format(r), tm.Format(time.RFC3339Nano))
+	}
+}
+
+// Format returns a string representation of the range in the form of "[start, end]".
+// Unlike TimeRange.String, it prints the times in nanoseconds,
+// so that a small difference appears in a test failure.
+func Format(r timerange.TimeRange) string {
+	return fmt.Sprintf("[%s, %s]", r.Start().Format(time.RFC3339Nano), r.End().Format(time.RFC3339Nano))
+}
+
+func formatAll(ranges []timerange.TimeRange) []string {
+	s := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		s = append(s, Format(r))
+	}
+	return s
+}
+
+func defaultOptions(opts []cmp.Option) []cmp.Option {
+	if len(opts) == 0 {
+		return []cmp.Option{IgnoreLocation()}
+	}
+	return opts
+}
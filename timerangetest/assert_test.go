@@ -0,0 +1,83 @@
+package timerangetest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+	"github.com/int128/go-timerange/timerangetest"
+)
+
+// recorder records the errors reported by an assertion.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertEqual(t *testing.T) {
+	r := timerangetest.MustParse("[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]")
+	t.Run("equal", func(t *testing.T) {
+		var rec recorder
+		timerangetest.AssertEqual(&rec, r, r.Shift(time.Millisecond), timerangetest.EquateTimeRange(time.Second))
+		if len(rec.errors) > 0 {
+			t.Errorf("want no error but was %v", rec.errors)
+		}
+	})
+	t.Run("not equal", func(t *testing.T) {
+		var rec recorder
+		timerangetest.AssertEqual(&rec, r, r.Shift(time.Millisecond))
+		want := "want [2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z] != got [2006-01-02T15:04:05.001Z, 2006-01-02T15:07:05.001Z]"
+		if len(rec.errors) != 1 || rec.errors[0] != want {
+			t.Errorf("want %q but was %q", want, rec.errors)
+		}
+	})
+}
+
+func TestAssertEqualSlices(t *testing.T) {
+	a := timerangetest.MustParse("[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]")
+	b := timerangetest.MustParse("[2006-01-02T16:04:05Z, 2006-01-02T16:07:05Z]")
+	t.Run("equal", func(t *testing.T) {
+		var rec recorder
+		timerangetest.AssertEqualSlices(&rec, []timerange.TimeRange{a, b}, []timerange.TimeRange{a, b})
+		if len(rec.errors) > 0 {
+			t.Errorf("want no error but was %v", rec.errors)
+		}
+	})
+	t.Run("not equal", func(t *testing.T) {
+		var rec recorder
+		timerangetest.AssertEqualSlices(&rec, []timerange.TimeRange{a, b}, []timerange.TimeRange{a})
+		if len(rec.errors) != 1 {
+			t.Fatalf("want 1 error but was %v", rec.errors)
+		}
+		if want := "[2006-01-02T16:04:05Z, 2006-01-02T16:07:05Z]"; !strings.Contains(rec.errors[0], want) {
+			t.Errorf("error wants %q but was %q", want, rec.errors[0])
+		}
+	})
+}
+
+func TestAssertContains(t *testing.T) {
+	r := timerangetest.MustParse("[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]")
+	var rec recorder
+	timerangetest.AssertContains(&rec, r, r.Start())
+	timerangetest.AssertNotContains(&rec, r, r.End().Add(time.Nanosecond))
+	if len(rec.errors) > 0 {
+		t.Errorf("want no error but was %v", rec.errors)
+	}
+	timerangetest.AssertContains(&rec, r, r.End().Add(time.Nanosecond))
+	timerangetest.AssertNotContains(&rec, r, r.Start())
+	want := []string{
+		"[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z] does not contain 2006-01-02T15:07:05.000000001Z",
+		"[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z] contains 2006-01-02T15:04:05Z",
+	}
+	if fmt.Sprint(want) != fmt.Sprint(rec.errors) {
+		t.Errorf("want %q but was %q", want, rec.errors)
+	}
+}
@@ -0,0 +1,48 @@
+package timerangetest
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange"
+)
+
+// EquateTimeRange returns a cmp.Option which treats ranges as equal
+// if both start times and end times are within the margin.
+// It compares the instants, that is, it ignores the locations and monotonic clock readings.
+// The margin must be non-negative.
+func EquateTimeRange(margin time.Duration) cmp.Option {
+	if margin < 0 {
+		panic("timerangetest.EquateTimeRange: margin must be non-negative")
+	}
+	return cmp.Comparer(func(a, b timerange.TimeRange) bool {
+		return withinMargin(a.Start(), b.Start(), margin) && withinMargin(a.End(), b.End(), margin)
+	})
+}
+
+// IgnoreLocation returns a cmp.Option which treats ranges as equal
+// if they represent the same instants in any location.
+// This is the same as TimeRange.Equal.
+func IgnoreLocation() cmp.Option {
+	return EquateTimeRange(0)
+}
+
+// EquateLocation returns a cmp.Option which treats ranges as equal
+// if they represent the same instants in the same location.
+// The locations are compared by name, because time.LoadLocation returns a new pointer each time.
+func EquateLocation() cmp.Option {
+	return cmp.Comparer(func(a, b timerange.TimeRange) bool {
+		return a.Equal(b) &&
+			a.Start().Location().String() == b.Start().Location().String() &&
+			a.End().Location().String() == b.End().Location().String()
+	})
+}
+
+func withinMargin(a, b time.Time, margin time.Duration) bool {
+	if a.Before(b) {
+		a, b = b, a
+	}
+	d := a.Sub(b)
+	// time.Time.Sub saturates if the difference exceeds the range of time.Duration
+	return d <= margin && b.Add(d).Equal(a)
+}
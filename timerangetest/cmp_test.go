@@ -0,0 +1,61 @@
+package timerangetest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/int128/go-timerange/timerangetest"
+)
+
+func TestEquateTimeRange(t *testing.T) {
+	r := timerangetest.MustParse("[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]")
+	t.Run("within the margin", func(t *testing.T) {
+		if !cmp.Equal(r, r.Shift(time.Second), timerangetest.EquateTimeRange(time.Second)) {
+			t.Errorf("want equal but was not")
+		}
+	})
+	t.Run("out of the margin", func(t *testing.T) {
+		if cmp.Equal(r, r.Shift(-time.Second-1), timerangetest.EquateTimeRange(time.Second)) {
+			t.Errorf("want not equal but was equal")
+		}
+	})
+	t.Run("end out of the margin", func(t *testing.T) {
+		if cmp.Equal(r, r.Extend(time.Minute), timerangetest.EquateTimeRange(time.Second)) {
+			t.Errorf("want not equal but was equal")
+		}
+	})
+	t.Run("far apart", func(t *testing.T) {
+		far := timerangetest.MustParse("[0001-01-01T00:00:00Z, 0001-01-01T00:00:00Z]")
+		if cmp.Equal(r, far, timerangetest.EquateTimeRange(time.Duration(1<<63-1))) {
+			t.Errorf("want not equal but was equal")
+		}
+	})
+	t.Run("in slices", func(t *testing.T) {
+		want := []any{r, r}
+		got := []any{r, r.Shift(time.Millisecond)}
+		if diff := cmp.Diff(want, got, timerangetest.EquateTimeRange(time.Second)); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestIgnoreLocation(t *testing.T) {
+	r := timerangetest.MustParse("[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]")
+	if !cmp.Equal(r, timerangetest.MustParse("[2006-01-03T00:04:05+09:00, 2006-01-03T00:07:05+09:00]"), timerangetest.IgnoreLocation()) {
+		t.Errorf("want equal but was not")
+	}
+	if cmp.Equal(r, r.Shift(time.Nanosecond), timerangetest.IgnoreLocation()) {
+		t.Errorf("want not equal but was equal")
+	}
+	t.Run("EquateLocation", func(t *testing.T) {
+		inJST := timerangetest.MustParse("[2006-01-03T00:04:05+09:00, 2006-01-03T00:07:05+09:00]")
+		if cmp.Equal(r, inJST, timerangetest.EquateLocation()) {
+			t.Errorf("want not equal but was equal")
+		}
+		a := timerangetest.MustParse("[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]")
+		if !cmp.Equal(r, a, timerangetest.EquateLocation()) {
+			t.Errorf("want equal but was not")
+		}
+	})
+}
@@ -0,0 +1,109 @@
+// Package timerangetest provides utilities for testing code which uses timerange.
+package timerangetest
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/int128/go-timerange"
+)
+
+// MaxFuzzDuration is the maximum duration of a TimeRange returned by FromFuzz.
+const MaxFuzzDuration = 100 * 365 * 24 * time.Hour
+
+// Generator generates random times and ranges for property-based tests.
+// It is deterministic for the seed, so a failure can be reproduced.
+// It is not safe for concurrent use.
+type Generator struct {
+	rng *rand.Rand
+
+	// Min and Max are the bounds of generated times.
+	Min, Max time.Time
+	// MaxDuration is the maximum duration of generated ranges.
+	MaxDuration time.Duration
+	// Locations are the locations of generated times.
+	Locations []*time.Location
+}
+
+// NewGenerator returns a Generator with the seed.
+// By default, it generates times between 1970 and 2100 in UTC,
+// and ranges up to 30 days.
+func NewGenerator(seed uint64) *Generator {
+	return &Generator{
+		rng:         rand.New(rand.NewPCG(seed, seed)),
+		Min:         time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		Max:         time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+		MaxDuration: 30 * 24 * time.Hour,
+		Locations:   []*time.Location{time.UTC},
+	}
+}
+
+// Time returns a random time between Min and Max in one of Locations.
+func (g *Generator) Time() time.Time {
+	span := g.Max.Sub(g.Min)
+	t := g.Min
+	if span > 0 {
+		t = t.Add(time.Duration(g.rng.Int64N(int64(span))))
+	}
+	// Generate an edge case sometimes
+	switch g.rng.IntN(10) {
+	case 0:
+		t = t.Truncate(time.Hour)
+	case 1:
+		t = t.Truncate(time.Second)
+	}
+	return t.In(g.Locations[g.rng.IntN(len(g.Locations))])
+}
+
+// Duration returns a random duration between 0 and MaxDuration.
+func (g *Generator) Duration() time.Duration {
+	switch g.rng.IntN(10) {
+	case 0:
+		return 0
+	case 1:
+		return g.MaxDuration
+	}
+	return time.Duration(g.rng.Int64N(int64(g.MaxDuration) + 1))
+}
+
+// TimeRange returns a random TimeRange which starts at Time and lasts for Duration.
+func (g *Generator) TimeRange() timerange.TimeRange {
+	return timerange.From(g.Time(), g.Duration())
+}
+
+// TimeRanges returns n random ranges.
+// Some of them overlap, touch or contain each other.
+func (g *Generator) TimeRanges(n int) []timerange.TimeRange {
+	ranges := make([]timerange.TimeRange, 0, n)
+	for range n {
+		if len(ranges) > 0 && g.rng.IntN(4) == 0 {
+			ranges = append(ranges, g.relatedTo(ranges[g.rng.IntN(len(ranges))]))
+			continue
+		}
+		ranges = append(ranges, g.TimeRange())
+	}
+	return ranges
+}
+
+// relatedTo returns a range which overlaps, touches or is contained in r.
+func (g *Generator) relatedTo(r timerange.TimeRange) timerange.TimeRange {
+	switch g.rng.IntN(3) {
+	case 0:
+		return timerange.From(r.End(), g.Duration())
+	case 1:
+		return timerange.New(r.Start().Add(r.Duration()/4), r.End().Add(-r.Duration()/4))
+	}
+	return timerange.From(r.Start().Add(r.Duration()/2), g.Duration())
+}
+
+// FromFuzz returns a TimeRange from the values given by a fuzz engine.
+// The start time is the Unix time in nanoseconds,
+// and the duration is wrapped into [0, MaxFuzzDuration].
+// It always returns a valid range, so it can be used with any input.
+func FromFuzz(startUnixNano, duration int64) timerange.TimeRange {
+	if duration < 0 {
+		duration = -(duration + 1)
+	}
+	start := time.Unix(0, startUnixNano).UTC()
+	return timerange.From(start, time.Duration(duration%(int64(MaxFuzzDuration)+1)))
+}
@@ -0,0 +1,59 @@
+package timerangetest_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange/timerangetest"
+)
+
+func TestGenerator(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	g := timerangetest.NewGenerator(1)
+	g.Locations = []*time.Location{time.UTC, jst}
+	for range 1000 {
+		r := g.TimeRange()
+		if r.IsZero() {
+			t.Fatalf("want a valid range but was zero")
+		}
+		if r.Start().Before(g.Min) || r.Start().After(g.Max) {
+			t.Errorf("start %v is out of bounds", r.Start())
+		}
+		if r.Duration() < 0 || r.Duration() > g.MaxDuration {
+			t.Errorf("duration %v is out of bounds", r.Duration())
+		}
+		if loc := r.Start().Location(); loc != time.UTC && loc != jst {
+			t.Errorf("unexpected location %v", loc)
+		}
+	}
+}
+
+func TestGenerator_deterministic(t *testing.T) {
+	a := timerangetest.NewGenerator(42).TimeRanges(100)
+	b := timerangetest.NewGenerator(42).TimeRanges(100)
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			t.Errorf("ranges[%d]: want %v != got %v", i, a[i], b[i])
+		}
+	}
+}
+
+func TestFromFuzz(t *testing.T) {
+	for _, c := range []struct {
+		start, duration int64
+	}{
+		{0, 0},
+		{math.MaxInt64, math.MaxInt64},
+		{math.MinInt64, math.MinInt64},
+		{math.MaxInt64, -1},
+	} {
+		r := timerangetest.FromFuzz(c.start, c.duration)
+		if r.IsZero() {
+			t.Errorf("FromFuzz(%d, %d) returned zero", c.start, c.duration)
+		}
+		if r.Duration() > timerangetest.MaxFuzzDuration {
+			t.Errorf("FromFuzz(%d, %d) returned too long range %v", c.start, c.duration, r)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package timerangetest
+
+import (
+	"fmt"
+
+	"github.com/int128/go-timerange"
+)
+
+// MustParse returns a TimeRange from the string in the form of timerange.Parse,
+// such as "[2006-01-02T15:04:05Z, 2006-01-02T16:04:05Z]" or "2006-01-02T15:04:05Z/PT1H".
+// It panics if the string is invalid.
+// It is intended for table-driven tests.
+func MustParse(s string) timerange.TimeRange {
+	r, err := timerange.Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("timerangetest.MustParse: %s", err))
+	}
+	return r
+}
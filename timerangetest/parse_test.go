@@ -0,0 +1,48 @@
+package timerangetest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/int128/go-timerange"
+	"github.com/int128/go-timerange/timerangetest"
+)
+
+func TestMustParse(t *testing.T) {
+	want := timerange.New(
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2006, 1, 2, 15, 7, 5, 0, time.UTC),
+	)
+	for _, s := range []string{
+		"[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z]",
+		"[2006-01-03T00:04:05+09:00,2006-01-02T15:07:05.000Z]",
+		"2006-01-02T15:04:05Z/2006-01-02T15:07:05Z",
+		"2006-01-02T15:04:05Z/PT3M",
+		want.String(),
+		timerangetest.Format(want),
+	} {
+		t.Run(s, func(t *testing.T) {
+			got := timerangetest.MustParse(s)
+			if !want.Equal(got) {
+				t.Errorf("want %v != got %v", want, got)
+			}
+		})
+	}
+	for _, s := range []string{
+		"",
+		"2006-01-02T15:04:05Z",
+		"[2006-01-02T15:04:05Z, 2006-01-02T15:07:05Z",
+		"[2006-01-02T15:04:05Z 2006-01-02T15:07:05Z]",
+		"[2006-01-02, 2006-01-03]",
+		"2006-01-02T15:07:05Z/2006-01-02T15:04:05Z",
+	} {
+		t.Run(s, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("want panic but was not")
+				}
+			}()
+			timerangetest.MustParse(s)
+		})
+	}
+}